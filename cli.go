@@ -0,0 +1,64 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"github.com/idena-network/idena-translation/config"
+	"github.com/idena-network/idena-translation/core"
+	"github.com/idena-network/idena-translation/core/export"
+	"github.com/idena-network/idena-translation/core/importer"
+	"github.com/idena-network/idena-translation/core/words_mapper"
+	"github.com/pkg/errors"
+	"io"
+	"os"
+)
+
+// The commands below need the db and the words only, the node client, the caches and the revalidator are not started
+
+func exportConfirmedTranslations(appConfig *config.Config, language, format, output string) error {
+	if _, ok := export.ContentType(format); !ok {
+		return errors.Errorf("unknown format '%v'", format)
+	}
+	var w io.Writer = os.Stdout
+	if len(output) > 0 {
+		file, err := os.Create(output)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		w = file
+	}
+	bufferedWriter := bufio.NewWriter(w)
+	if err := initExporter(appConfig).ExportConfirmedTranslations(context.Background(), language, format, bufferedWriter); err != nil {
+		return err
+	}
+	return bufferedWriter.Flush()
+}
+
+func initExporter(appConfig *config.Config) *core.Exporter {
+	return core.NewExporter(initDbAccessor(appConfig), words_mapper.NewWordsMapper(appConfig.WordsUrl), initScoring(appConfig))
+}
+
+func importTranslations(appConfig *config.Config, fileName, format, source, language string, dryRun bool) error {
+	file, err := os.Open(fileName)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	rows, err := importer.ReadRows(format, file, language)
+	if err != nil {
+		return err
+	}
+	report, err := initImporter(appConfig).ImportTranslations(context.Background(), rows, source, dryRun)
+	if err != nil {
+		return err
+	}
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(report)
+}
+
+func initImporter(appConfig *config.Config) *core.Importer {
+	return core.NewImporter(initDbAccessor(appConfig), words_mapper.NewWordsMapper(appConfig.WordsUrl))
+}
@@ -8,24 +8,125 @@ import (
 	"path/filepath"
 )
 
+const (
+	PostgresDbType = "postgres"
+	MemoryDbType   = "memory"
+
+	LocalSignatureRecovery = "local"
+	NodeSignatureRecovery  = "node"
+
+	RestApiBackend = "rest"
+	RpcApiBackend  = "rpc"
+
+	MemoryRateLimitStore = "memory"
+	DbRateLimitStore     = "db"
+)
+
 type Config struct {
 	Server        ServerConfig
 	Api           ApiConfig
+	DbType        string
 	Postgres      PostgresConfig
 	Verbosity     int
 	Swagger       SwaggerConfig
 	ItemsLimit    uint8
 	ConfirmedRate uint8
-	WordsUrl      string
+	// WeightedScore enables sorting and confirmation of translations by the difference of weighted votes
+	WeightedScore bool
+	// VoteWeights are weights of votes by identity state, e.g. {"Human": 3}, states missing in the map have weight 1
+	VoteWeights       map[string]float64
+	WordsUrl          string
+	ContinuationToken ContinuationTokenConfig
+	Revalidation      RevalidationConfig
+	// SignedRequestWindowSec is the maximum difference between timestamps of signed requests and server time, accepted
+	// requests are remembered for this time to reject replays, 0 disables both checks
+	SignedRequestWindowSec int
+	Signing                SigningConfig
+	RateLimits             RateLimitsConfig
+	Moderation             ModerationConfig
 }
 
 type PostgresConfig struct {
 	ConnStr    string
 	ScriptsDir string
+	// ReplicaConnStrs are connection strings of read replicas, read queries go to the primary if the list is empty
+	ReplicaConnStrs []string
+	// MaxReplicaLagSec is the replication lag after which the replica is not used until it catches up, 0 means no limit
+	MaxReplicaLagSec int
+	// ReplicaCheckIntervalSec is the interval of replica availability and lag checks
+	ReplicaCheckIntervalSec int
+	// Pool settings of the primary and every replica, 0 means database/sql default
+	MaxOpenConns       int
+	MaxIdleConns       int
+	ConnMaxLifetimeSec int
+	ConnMaxIdleTimeSec int
 }
 
 type ServerConfig struct {
 	Port int
+	// AdminApiKey is required in 'api-key' header of admin requests, admin requests are disabled if it is empty
+	AdminApiKey string
+	// ModeratorApiKey is required in 'api-key' header of moderation requests, the admin key is accepted as well
+	ModeratorApiKey string
+	// RequestTimeoutSec limits processing time of a request including db queries and node calls, 0 means no limit
+	RequestTimeoutSec int
+	// RouteTimeoutsSec overrides RequestTimeoutSec for routes by their swagger operation id, e.g. "importTranslations"
+	RouteTimeoutsSec map[string]int
+	// TrustedProxies are ip addresses or CIDR ranges of reverse proxies whose X-Forwarded-For header is used to get
+	// client ips for rate limits, the header is ignored if the list is empty
+	TrustedProxies []string
+}
+
+type ContinuationTokenConfig struct {
+	// Secret authenticates continuation tokens, it has to be the same on all instances serving the api.
+	// A random secret is generated on start if it is empty, so tokens are not valid after restart.
+	Secret string
+	TtlSec int
+}
+
+type SigningConfig struct {
+	// Network is the domain tag of signed values of version 2, e.g. "mainnet" or "testnet"
+	Network string
+	// AcceptLegacy allows requests signed in the legacy format without field separators and domain tag
+	AcceptLegacy bool
+}
+
+type RevalidationConfig struct {
+	// Enabled starts the job that applies identity states of the new epoch to votes and translations
+	Enabled bool
+	// CheckIntervalSec is the interval of epoch checks
+	CheckIntervalSec int
+	// TimeoutSec limits the time of the revalidation including requests of identity states of all participants
+	TimeoutSec int
+	// Concurrency is the number of identity states requested at once
+	Concurrency int
+}
+
+type RateLimitsConfig struct {
+	// Store is either "memory" to keep limits within the instance or "db" to share them between instances via the db
+	Store string
+	// Routes are limits of routes by their swagger operation id, "submitTranslation", "vote" and "report" are limited,
+	// vote retractions are limited by "vote"
+	Routes map[string]RouteRateLimitsConfig
+}
+
+type RouteRateLimitsConfig struct {
+	// Address limits requests signed by an address
+	Address RateLimitConfig
+	// Ip limits requests sent from a client ip
+	Ip RateLimitConfig
+}
+
+type RateLimitConfig struct {
+	// PerMinute is the number of requests allowed per minute on average, 0 disables the limit
+	PerMinute float64
+	// Burst is the number of requests allowed at once
+	Burst int
+}
+
+type ModerationConfig struct {
+	// HideThreshold is the number of unresolved reports that hides the translation pending review, 0 disables hiding
+	HideThreshold int
 }
 
 type SwaggerConfig struct {
@@ -35,7 +136,32 @@ type SwaggerConfig struct {
 }
 
 type ApiConfig struct {
-	Url string
+	// Backend is either "rest" to call the indexer rest api or "rpc" to call the json-rpc api of idena-go nodes
+	Backend string
+	Url     string
+	// Urls are urls of additional nodes, requests go to healthy nodes in turn and fail over to other ones
+	Urls []string
+	// TimeoutSec limits a single request to a node
+	TimeoutSec int
+	// Retries is the number of additional attempts of a request that failed because the node is unavailable
+	Retries int
+	// RetryBackoffMs is the delay before the first retry, it doubles with every next retry up to MaxRetryBackoffMs
+	RetryBackoffMs    int
+	MaxRetryBackoffMs int
+	// BreakerFailures is the number of consecutive failures after which the node is skipped for BreakerCooldownSec,
+	// 0 disables the breaker
+	BreakerFailures    int
+	BreakerCooldownSec int
+	// HealthCheckIntervalSec is the interval of node health checks, 0 disables checks
+	HealthCheckIntervalSec int
+	// ApiKey is the api key of idena-go nodes, it is used by the "rpc" backend only
+	ApiKey string
+	// SignatureRecovery is either "local" to recover signature addresses in process or "node" to call the node api
+	SignatureRecovery string
+	// IdentityCacheTtlSec is the time identities are cached for, 0 disables the cache
+	IdentityCacheTtlSec int
+	// EpochCheckIntervalSec is the interval of epoch checks, the identity cache is dropped when the epoch changes
+	EpochCheckIntervalSec int
 }
 
 func LoadConfig(configPath string) *Config {
@@ -58,16 +184,79 @@ func LoadConfig(configPath string) *Config {
 func newDefaultConfig() *Config {
 	return &Config{
 		Server: ServerConfig{
-			Port: 80,
+			Port:              80,
+			RequestTimeoutSec: 30,
+			RouteTimeoutsSec: map[string]int{
+				"exportConfirmedTranslations": 600,
+				"importTranslations":          600,
+			},
 		},
 		Swagger: SwaggerConfig{
 			Enabled: false,
 		},
+		Api: ApiConfig{
+			Backend:                RestApiBackend,
+			TimeoutSec:             5,
+			Retries:                2,
+			RetryBackoffMs:         100,
+			MaxRetryBackoffMs:      2000,
+			BreakerFailures:        3,
+			BreakerCooldownSec:     30,
+			HealthCheckIntervalSec: 10,
+			SignatureRecovery:      LocalSignatureRecovery,
+			IdentityCacheTtlSec:    300,
+			EpochCheckIntervalSec:  30,
+		},
+		DbType: PostgresDbType,
 		Postgres: PostgresConfig{
-			ScriptsDir: filepath.Join("resources"),
+			ScriptsDir:              filepath.Join("resources"),
+			MaxReplicaLagSec:        10,
+			ReplicaCheckIntervalSec: 5,
+			MaxOpenConns:            50,
+			MaxIdleConns:            10,
+			ConnMaxLifetimeSec:      1800,
 		},
 		Verbosity:     5,
 		ItemsLimit:    50,
 		ConfirmedRate: 5,
+		VoteWeights: map[string]float64{
+			"Newbie":   1,
+			"Verified": 1,
+			"Human":    1,
+		},
+		ContinuationToken: ContinuationTokenConfig{
+			TtlSec: 3600,
+		},
+		Revalidation: RevalidationConfig{
+			Enabled:          true,
+			CheckIntervalSec: 60,
+			TimeoutSec:       3600,
+			Concurrency:      10,
+		},
+		SignedRequestWindowSec: 600,
+		Signing: SigningConfig{
+			Network:      "mainnet",
+			AcceptLegacy: true,
+		},
+		RateLimits: RateLimitsConfig{
+			Store: MemoryRateLimitStore,
+			Routes: map[string]RouteRateLimitsConfig{
+				"submitTranslation": {
+					Address: RateLimitConfig{PerMinute: 10, Burst: 20},
+					Ip:      RateLimitConfig{PerMinute: 60, Burst: 120},
+				},
+				"vote": {
+					Address: RateLimitConfig{PerMinute: 30, Burst: 60},
+					Ip:      RateLimitConfig{PerMinute: 180, Burst: 360},
+				},
+				"report": {
+					Address: RateLimitConfig{PerMinute: 5, Burst: 10},
+					Ip:      RateLimitConfig{PerMinute: 30, Burst: 60},
+				},
+			},
+		},
+		Moderation: ModerationConfig{
+			HideThreshold: 5,
+		},
 	}
 }
@@ -0,0 +1,136 @@
+package continuation
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"github.com/idena-network/idena-translation/db"
+	"github.com/idena-network/idena-translation/types"
+	"strings"
+	"time"
+)
+
+const (
+	// RatingOrder is the order of translations by rate descending and then by id
+	RatingOrder = "rating"
+	// AddressTranslationsOrder is the order of translations of the address by id descending
+	AddressTranslationsOrder = "addressTranslations"
+	// AddressVotesOrder is the order of votes of the address by translation id descending
+	AddressVotesOrder = "addressVotes"
+)
+
+// Codec builds opaque continuation tokens authenticated with HMAC-SHA256. A token is bound to the list it was issued
+// for, i.e. to the word and language or to the address, and to the order of the list. It expires after ttl.
+type Codec struct {
+	secret []byte
+	ttl    time.Duration
+	now    func() time.Time
+}
+
+func NewCodec(secret []byte, ttl time.Duration) *Codec {
+	return &Codec{
+		secret: secret,
+		ttl:    ttl,
+		now:    time.Now,
+	}
+}
+
+type payload struct {
+	WordId    uint32  `json:"w"`
+	Language  string  `json:"l"`
+	Address   string  `json:"a,omitempty"`
+	Order     string  `json:"o"`
+	Id        int     `json:"i"`
+	Rate      float64 `json:"r"`
+	Backward  bool    `json:"b,omitempty"`
+	ExpiresAt int64   `json:"e"`
+}
+
+func (c *Codec) Encode(wordId uint32, language string, order string, cursor db.TranslationsCursor) string {
+	return c.encode(payload{
+		WordId:   wordId,
+		Language: strings.ToLower(language),
+		Order:    order,
+		Id:       cursor.Id,
+		Rate:     cursor.Rate,
+		Backward: cursor.Backward,
+	})
+}
+
+// Decode returns the cursor of the token or nil if the token is empty
+func (c *Codec) Decode(token string, wordId uint32, language string, order string) (*db.TranslationsCursor, error) {
+	if len(token) == 0 {
+		return nil, nil
+	}
+	p, err := c.decode(token)
+	if err != nil {
+		return nil, err
+	}
+	if p.WordId != wordId || p.Language != strings.ToLower(language) || len(p.Address) > 0 || p.Order != order {
+		return nil, types.MismatchedContinuationTokenError
+	}
+	if c.now().Unix() > p.ExpiresAt {
+		return nil, types.ExpiredContinuationTokenError
+	}
+	return &db.TranslationsCursor{
+		Id:       p.Id,
+		Rate:     p.Rate,
+		Backward: p.Backward,
+	}, nil
+}
+
+// EncodeAddress builds the token of the next page of the list of the address, id is the id of the last item
+func (c *Codec) EncodeAddress(address string, order string, id int) string {
+	return c.encode(payload{
+		Address: strings.ToLower(address),
+		Order:   order,
+		Id:      id,
+	})
+}
+
+// DecodeAddress returns the id of the last item of the previous page or 0 if the token is empty
+func (c *Codec) DecodeAddress(token string, address string, order string) (int, error) {
+	if len(token) == 0 {
+		return 0, nil
+	}
+	p, err := c.decode(token)
+	if err != nil {
+		return 0, err
+	}
+	if p.Address != strings.ToLower(address) || len(p.Address) == 0 || p.Order != order {
+		return 0, types.MismatchedContinuationTokenError
+	}
+	if c.now().Unix() > p.ExpiresAt {
+		return 0, types.ExpiredContinuationTokenError
+	}
+	return p.Id, nil
+}
+
+func (c *Codec) encode(p payload) string {
+	p.ExpiresAt = c.now().Add(c.ttl).Unix()
+	data, _ := json.Marshal(p)
+	return base64.RawURLEncoding.EncodeToString(append(data, c.sign(data)...))
+}
+
+func (c *Codec) decode(token string) (payload, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(b) <= sha256.Size {
+		return payload{}, types.InvalidContinuationTokenError
+	}
+	data, mac := b[:len(b)-sha256.Size], b[len(b)-sha256.Size:]
+	if !hmac.Equal(mac, c.sign(data)) {
+		return payload{}, types.InvalidContinuationTokenError
+	}
+	var p payload
+	if err := json.Unmarshal(data, &p); err != nil {
+		return payload{}, types.InvalidContinuationTokenError
+	}
+	return p, nil
+}
+
+func (c *Codec) sign(data []byte) []byte {
+	mac := hmac.New(sha256.New, c.secret)
+	mac.Write(data)
+	return mac.Sum(nil)
+}
@@ -1,29 +1,60 @@
 package core
 
 import (
-	"fmt"
+	"context"
+	"github.com/idena-network/idena-translation/core/continuation"
+	"github.com/idena-network/idena-translation/core/languages"
+	"github.com/idena-network/idena-translation/core/ratelimit"
+	"github.com/idena-network/idena-translation/core/replay"
+	"github.com/idena-network/idena-translation/core/signing"
 	"github.com/idena-network/idena-translation/core/words_mapper"
 	"github.com/idena-network/idena-translation/db"
 	"github.com/idena-network/idena-translation/node"
 	"github.com/idena-network/idena-translation/types"
+	"io"
+	"math"
+	"strconv"
 	"strings"
 	"time"
 )
 
 type Engine interface {
-	SubmitTranslation(request types.SubmitTranslationRequest) (types.SubmitTranslationResponse, error)
-	GetTranslations(wordId uint32, language string, continuationToken string) (types.GetTranslationsResponse, string, error)
-	Vote(request types.VoteRequest) (types.VoteResponse, error)
-	GetConfirmedTranslation(wordId uint32, language string) (types.GetConfirmedTranslationResponse, error)
+	SubmitTranslation(ctx context.Context, request types.SubmitTranslationRequest) (types.SubmitTranslationResponse, error)
+	GetTranslations(ctx context.Context, wordId uint32, language string, continuationToken string, withTotal bool, address string) (types.GetTranslationsResponse, PageTokens, error)
+	Vote(ctx context.Context, request types.VoteRequest) (types.VoteResponse, error)
+	RetractVote(ctx context.Context, request types.RetractVoteRequest) (types.RetractVoteResponse, error)
+	GetConfirmedTranslation(ctx context.Context, wordId uint32, language string, address string) (types.GetConfirmedTranslationResponse, error)
+	GetConfirmedTranslationsByWords(ctx context.Context, request types.GetConfirmedTranslationsByWordsRequest) (types.GetConfirmedTranslationsByWordsResponse, error)
+	GetTranslationHistory(ctx context.Context, translationId string) (types.GetTranslationHistoryResponse, error)
+	GetAddressTranslations(ctx context.Context, address string, continuationToken string) (types.GetAddressTranslationsResponse, PageTokens, error)
+	GetAddressVotes(ctx context.Context, address string, continuationToken string) (types.GetAddressVotesResponse, PageTokens, error)
+	GetLanguages(ctx context.Context) (types.GetLanguagesResponse, error)
+	AddLanguage(ctx context.Context, request types.AddLanguageRequest) (types.Language, error)
+	DisableLanguage(ctx context.Context, language string) (types.Language, error)
+	ExportConfirmedTranslations(ctx context.Context, language string, format string, w io.Writer) error
+	ImportTranslations(ctx context.Context, rows []types.ImportTranslationRow, source string, dryRun bool) (types.ImportTranslationsResponse, error)
+	GetNodeStatus(ctx context.Context) (types.GetNodeStatusResponse, error)
+	Report(ctx context.Context, request types.ReportRequest) (types.ReportResponse, error)
+	GetModerationQueue(ctx context.Context) (types.GetModerationQueueResponse, error)
+	RestoreTranslation(ctx context.Context, translationId string) (types.ModeratedTranslation, error)
+	RemoveTranslation(ctx context.Context, translationId string) (types.ModeratedTranslation, error)
 }
 
-func NewEngine(dbAccessor db.Accessor, nodeClient node.Client, itemsLimit, confirmedRate uint8, wordsMapper words_mapper.WordsMapper) Engine {
+func NewEngine(dbAccessor db.Accessor, nodeClient node.Client, itemsLimit uint8, scoring db.Scoring, voteWeights VoteWeights, wordsMapper words_mapper.WordsMapper, tokenCodec *continuation.Codec, requestGuard *replay.Guard, signingFormat signing.Format, rateLimiter *ratelimit.Limiter, hideThreshold int) Engine {
 	return &engineImpl{
 		dbAccessor:    dbAccessor,
 		nodeClient:    nodeClient,
 		itemsLimit:    itemsLimit,
-		confirmedRate: confirmedRate,
+		scoring:       scoring,
+		voteWeights:   voteWeights,
 		wordsMapper:   wordsMapper,
+		tokenCodec:    tokenCodec,
+		requestGuard:  requestGuard,
+		signingFormat: signingFormat,
+		rateLimiter:   rateLimiter,
+		hideThreshold: hideThreshold,
+		exporter:      NewExporter(dbAccessor, wordsMapper, scoring),
+		importer:      NewImporter(dbAccessor, wordsMapper),
 	}
 }
 
@@ -31,41 +62,67 @@ type engineImpl struct {
 	nodeClient    node.Client
 	dbAccessor    db.Accessor
 	itemsLimit    uint8
-	confirmedRate uint8
+	scoring       db.Scoring
+	voteWeights   VoteWeights
 	wordsMapper   words_mapper.WordsMapper
+	tokenCodec    *continuation.Codec
+	requestGuard  *replay.Guard
+	signingFormat signing.Format
+	rateLimiter   *ratelimit.Limiter
+	// hideThreshold is the number of unresolved reports that hides the translation pending review, 0 disables hiding
+	hideThreshold int
+	exporter      *Exporter
+	importer      *Importer
 }
 
-func (engine *engineImpl) SubmitTranslation(request types.SubmitTranslationRequest) (types.SubmitTranslationResponse, error) {
+func (engine *engineImpl) SubmitTranslation(ctx context.Context, request types.SubmitTranslationRequest) (res types.SubmitTranslationResponse, err error) {
 	if err := request.Validate(); err != nil {
 		return types.SubmitTranslationResponse{}, &types.BadRequestError{
 			Message: err.Error(),
 		}
 	}
-	address, err := engine.nodeClient.GetSignatureAddress(getTranslationSignedValue(request), request.Signature)
+	signed, retryAfter, err := engine.acceptSignedRequest(ctx, "submitTranslation", "submitTranslation", request.Timestamp, request.Signature, func() (string, error) {
+		return engine.signingFormat.SubmitTranslationValue(request)
+	})
 	if err != nil {
+		if translationError, ok := err.(*types.TranslationError); ok {
+			return types.SubmitTranslationResponse{
+				ResCode:    translationError.Code(),
+				Error:      translationError.Error(),
+				RetryAfter: retryAfter,
+			}, nil
+		}
 		return types.SubmitTranslationResponse{}, err
 	}
-	isIdentity, err := engine.nodeClient.IsIdentity(address)
+	defer func() {
+		if err != nil {
+			engine.requestGuard.Forget(signed.key)
+		}
+	}()
+	identity, err := engine.nodeClient.GetIdentity(ctx, signed.address)
 	if err != nil {
 		return types.SubmitTranslationResponse{}, err
 	}
-	if !isIdentity {
+	if !identity.IsValidated() {
 		return types.SubmitTranslationResponse{
 			ResCode: types.NotIdentityError.Code(),
 			Error:   types.NotIdentityError.Error(),
 		}, nil
 	}
+	language, err := languages.Canonicalize(request.Language)
+	if err != nil {
+		return types.SubmitTranslationResponse{}, err
+	}
 	var translationId *string
-	var timestamp time.Time
-	_ = timestamp.UnmarshalText([]byte(request.Timestamp))
 	if translationId, err = engine.dbAccessor.SubmitTranslation(
-		address,
+		ctx,
+		signed.address,
 		engine.wordsMapper.GetInitialWordId(request.Word),
-		request.Language,
+		language,
 		request.Name,
 		request.Description,
-		timestamp,
-		engine.confirmedRate,
+		signed.timestamp,
+		engine.scoring,
 	); err != nil {
 		if translationError, ok := err.(*types.TranslationError); ok {
 			return types.SubmitTranslationResponse{
@@ -81,54 +138,168 @@ func (engine *engineImpl) SubmitTranslation(request types.SubmitTranslationReque
 	}, nil
 }
 
-func getTranslationSignedValue(request types.SubmitTranslationRequest) string {
-	return strings.Join([]string{fmt.Sprint(request.Word), request.Language, request.Name, request.Description, request.Timestamp}, "")
+// signedRequest is the signed request accepted for processing
+type signedRequest struct {
+	address   string
+	timestamp time.Time
+	// key is the replay key of the request, the key of the failed request is forgotten so it can be repeated
+	key string
 }
 
-func (engine *engineImpl) GetTranslations(wordId uint32, language string, continuationToken string) (types.GetTranslationsResponse, string, error) {
-	translations, nextContinuationToken, err := engine.dbAccessor.GetTranslations(
-		engine.wordsMapper.GetInitialWordId(wordId),
+// acceptSignedRequest rate limits the request by the ip, checks the timestamp window, recovers the signer, rate limits
+// the request by the signer address and remembers it to reject replays. The rejected request is returned as
+// TranslationError with the number of seconds after which the rate limited request can be repeated.
+func (engine *engineImpl) acceptSignedRequest(ctx context.Context, method, limitKey, timestampValue, signature string, signedValue func() (string, error)) (signedRequest, int, error) {
+	if retryAfter := engine.rateLimiter.AllowIp(ctx, limitKey, ratelimit.Ip(ctx)); retryAfter > 0 {
+		return signedRequest{}, retryAfterSec(retryAfter), types.RateLimitedError
+	}
+	var timestamp time.Time
+	_ = timestamp.UnmarshalText([]byte(timestampValue))
+	if err := engine.requestGuard.CheckTimestamp(timestamp); err != nil {
+		return signedRequest{}, 0, types.TimestampOutOfWindowError
+	}
+	value, err := signedValue()
+	if err != nil {
+		return signedRequest{}, 0, err
+	}
+	address, err := engine.nodeClient.GetSignatureAddress(ctx, value, signature)
+	if err != nil {
+		return signedRequest{}, 0, err
+	}
+	if retryAfter := engine.rateLimiter.AllowAddress(ctx, limitKey, address); retryAfter > 0 {
+		return signedRequest{}, retryAfterSec(retryAfter), types.RateLimitedError
+	}
+	key := replayKey(method, address, value)
+	if err := engine.requestGuard.Remember(key, timestamp); err != nil {
+		return signedRequest{}, 0, types.ReplayedRequestError
+	}
+	return signedRequest{
+		address:   address,
+		timestamp: timestamp,
+		key:       key,
+	}, 0, nil
+}
+
+// replayKey identifies the signed request, the address is a part of the key since the same value can be signed by
+// several addresses and a signature can be changed without changing the signer
+func replayKey(method, address, signedValue string) string {
+	return strings.Join([]string{method, strings.ToLower(address), signedValue}, ":")
+}
+
+// retryAfterSec rounds the time up to seconds of the Retry-After header
+func retryAfterSec(retryAfter time.Duration) int {
+	return int(math.Ceil(retryAfter.Seconds()))
+}
+
+// PageTokens are continuation tokens of the pages around the returned one, a token is empty if there is no such page
+type PageTokens struct {
+	Next string
+	Prev string
+}
+
+func (engine *engineImpl) GetTranslations(ctx context.Context, wordId uint32, language string, continuationToken string, withTotal bool, address string) (types.GetTranslationsResponse, PageTokens, error) {
+	language, err := languages.Canonicalize(language)
+	if err != nil {
+		return types.GetTranslationsResponse{}, PageTokens{}, err
+	}
+	cursor, err := engine.tokenCodec.Decode(continuationToken, wordId, language, continuation.RatingOrder)
+	if err != nil {
+		return types.GetTranslationsResponse{}, PageTokens{}, err
+	}
+	initialWordId := engine.wordsMapper.GetInitialWordId(wordId)
+	translations, hasMore, err := engine.dbAccessor.GetTranslations(
+		ctx,
+		initialWordId,
 		language,
-		continuationToken,
+		cursor,
 		engine.itemsLimit,
-		engine.confirmedRate,
+		engine.scoring,
+		address,
 	)
 	if err != nil {
-		return types.GetTranslationsResponse{}, "", err
+		return types.GetTranslationsResponse{}, PageTokens{}, err
 	}
-	return types.GetTranslationsResponse{
+	res := types.GetTranslationsResponse{
 		Translations: translations,
-	}, nextContinuationToken, nil
+	}
+	if withTotal {
+		total, err := engine.dbAccessor.CountTranslations(ctx, initialWordId, language)
+		if err != nil {
+			return types.GetTranslationsResponse{}, PageTokens{}, err
+		}
+		res.Total = &total
+	}
+	backward := cursor != nil && cursor.Backward
+	hasNext, hasPrev := hasMore, cursor != nil
+	if backward {
+		hasNext, hasPrev = true, hasMore
+	}
+	var tokens PageTokens
+	if len(translations) == 0 {
+		return res, tokens, nil
+	}
+	if hasNext {
+		last := translations[len(translations)-1]
+		tokens.Next = engine.tokenCodec.Encode(wordId, language, continuation.RatingOrder, engine.translationCursor(last, false))
+	}
+	if hasPrev {
+		first := translations[0]
+		tokens.Prev = engine.tokenCodec.Encode(wordId, language, continuation.RatingOrder, engine.translationCursor(first, true))
+	}
+	return res, tokens, nil
 }
 
-func (engine *engineImpl) Vote(request types.VoteRequest) (types.VoteResponse, error) {
+func (engine *engineImpl) translationCursor(translation types.Translation, backward bool) db.TranslationsCursor {
+	id, _ := strconv.Atoi(translation.Id)
+	return db.TranslationsCursor{
+		Id:       id,
+		Rate:     engine.scoring.Rate(translation),
+		Backward: backward,
+	}
+}
+
+func (engine *engineImpl) Vote(ctx context.Context, request types.VoteRequest) (res types.VoteResponse, err error) {
 	if err := request.Validate(); err != nil {
 		return types.VoteResponse{}, &types.BadRequestError{
 			Message: err.Error(),
 		}
 	}
-	address, err := engine.nodeClient.GetSignatureAddress(getVoteSignedValue(request), request.Signature)
+	signed, retryAfter, err := engine.acceptSignedRequest(ctx, "vote", "vote", request.Timestamp, request.Signature, func() (string, error) {
+		return engine.signingFormat.VoteValue(request)
+	})
 	if err != nil {
+		if translationError, ok := err.(*types.TranslationError); ok {
+			return types.VoteResponse{
+				ResCode:    translationError.Code(),
+				Error:      translationError.Error(),
+				RetryAfter: retryAfter,
+			}, nil
+		}
 		return types.VoteResponse{}, err
 	}
-	isIdentity, err := engine.nodeClient.IsIdentity(address)
+	defer func() {
+		if err != nil {
+			engine.requestGuard.Forget(signed.key)
+		}
+	}()
+	identity, err := engine.nodeClient.GetIdentity(ctx, signed.address)
 	if err != nil {
 		return types.VoteResponse{}, err
 	}
-	if !isIdentity {
+	if !identity.IsValidated() {
 		return types.VoteResponse{
 			ResCode: types.NotIdentityError.Code(),
 			Error:   types.NotIdentityError.Error(),
 		}, nil
 	}
-	var timestamp time.Time
-	_ = timestamp.UnmarshalText([]byte(request.Timestamp))
-	var upVotes, downVotes int
-	if upVotes, downVotes, err = engine.dbAccessor.Vote(
-		address,
+	var counts db.VoteCounts
+	if counts, err = engine.dbAccessor.Vote(
+		ctx,
+		signed.address,
 		request.TranslationId,
 		request.Up,
-		timestamp,
+		engine.voteWeights.Weight(identity),
+		signed.timestamp,
 	); err != nil {
 		if translationError, ok := err.(*types.TranslationError); ok {
 			return types.VoteResponse{
@@ -139,21 +310,70 @@ func (engine *engineImpl) Vote(request types.VoteRequest) (types.VoteResponse, e
 		return types.VoteResponse{}, err
 	}
 	return types.VoteResponse{
-		ResCode:   types.SuccessResCode,
-		UpVotes:   upVotes,
-		DownVotes: downVotes,
+		ResCode:           types.SuccessResCode,
+		UpVotes:           counts.UpVotes,
+		DownVotes:         counts.DownVotes,
+		WeightedUpVotes:   counts.WeightedUpVotes,
+		WeightedDownVotes: counts.WeightedDownVotes,
 	}, nil
 }
 
-func getVoteSignedValue(request types.VoteRequest) string {
-	return strings.Join([]string{request.TranslationId, fmt.Sprint(request.Up), request.Timestamp}, "")
+// RetractVote is limited together with votes, so alternating votes and retractions don't bypass the vote limits
+func (engine *engineImpl) RetractVote(ctx context.Context, request types.RetractVoteRequest) (res types.RetractVoteResponse, err error) {
+	if err := request.Validate(); err != nil {
+		return types.RetractVoteResponse{}, &types.BadRequestError{
+			Message: err.Error(),
+		}
+	}
+	signed, retryAfter, err := engine.acceptSignedRequest(ctx, "retractVote", "vote", request.Timestamp, request.Signature, func() (string, error) {
+		return engine.signingFormat.RetractVoteValue(request)
+	})
+	if err != nil {
+		if translationError, ok := err.(*types.TranslationError); ok {
+			return types.RetractVoteResponse{
+				ResCode:    translationError.Code(),
+				Error:      translationError.Error(),
+				RetryAfter: retryAfter,
+			}, nil
+		}
+		return types.RetractVoteResponse{}, err
+	}
+	defer func() {
+		if err != nil {
+			engine.requestGuard.Forget(signed.key)
+		}
+	}()
+	// The identity is not checked since the retraction only removes the vote of the address
+	var counts db.VoteCounts
+	if counts, err = engine.dbAccessor.RetractVote(ctx, signed.address, request.TranslationId, signed.timestamp); err != nil {
+		if translationError, ok := err.(*types.TranslationError); ok {
+			return types.RetractVoteResponse{
+				ResCode: translationError.Code(),
+				Error:   translationError.Error(),
+			}, nil
+		}
+		return types.RetractVoteResponse{}, err
+	}
+	return types.RetractVoteResponse{
+		ResCode:           types.SuccessResCode,
+		UpVotes:           counts.UpVotes,
+		DownVotes:         counts.DownVotes,
+		WeightedUpVotes:   counts.WeightedUpVotes,
+		WeightedDownVotes: counts.WeightedDownVotes,
+	}, nil
 }
 
-func (engine *engineImpl) GetConfirmedTranslation(wordId uint32, language string) (types.GetConfirmedTranslationResponse, error) {
+func (engine *engineImpl) GetConfirmedTranslation(ctx context.Context, wordId uint32, language string, address string) (types.GetConfirmedTranslationResponse, error) {
+	language, err := languages.Canonicalize(language)
+	if err != nil {
+		return types.GetConfirmedTranslationResponse{}, err
+	}
 	translation, err := engine.dbAccessor.GetConfirmedTranslation(
+		ctx,
 		engine.wordsMapper.GetInitialWordId(wordId),
 		language,
-		engine.confirmedRate,
+		engine.scoring,
+		address,
 	)
 	if err != nil {
 		return types.GetConfirmedTranslationResponse{}, err
@@ -162,3 +382,291 @@ func (engine *engineImpl) GetConfirmedTranslation(wordId uint32, language string
 		Translation: translation,
 	}, nil
 }
+
+// GetConfirmedTranslationsByWords gets confirmed translations of all the words and languages by a single query, the
+// result has an entry for every requested word and language
+func (engine *engineImpl) GetConfirmedTranslationsByWords(ctx context.Context, request types.GetConfirmedTranslationsByWordsRequest) (types.GetConfirmedTranslationsByWordsResponse, error) {
+	if err := request.Validate(); err != nil {
+		return types.GetConfirmedTranslationsByWordsResponse{}, &types.BadRequestError{
+			Message: err.Error(),
+		}
+	}
+	requestedLanguages := make([]string, 0, len(request.Languages))
+	for _, language := range request.Languages {
+		language, err := languages.Canonicalize(language)
+		if err != nil {
+			return types.GetConfirmedTranslationsByWordsResponse{}, err
+		}
+		requestedLanguages = append(requestedLanguages, language)
+	}
+	initialWordIds := make(map[uint32]uint32, len(request.Words))
+	var wordIds []uint32
+	for _, wordId := range request.Words {
+		if _, ok := initialWordIds[wordId]; ok {
+			continue
+		}
+		initialWordId := engine.wordsMapper.GetInitialWordId(wordId)
+		initialWordIds[wordId] = initialWordId
+		wordIds = append(wordIds, initialWordId)
+	}
+	translations, err := engine.dbAccessor.GetConfirmedTranslationsByWords(ctx, wordIds, requestedLanguages, engine.scoring)
+	if err != nil {
+		return types.GetConfirmedTranslationsByWordsResponse{}, err
+	}
+	res := types.GetConfirmedTranslationsByWordsResponse{
+		Translations: make(map[string]map[string]*types.Translation, len(requestedLanguages)),
+	}
+	for _, language := range requestedLanguages {
+		translationsByWordId := translations[strings.ToLower(language)]
+		words := make(map[string]*types.Translation, len(initialWordIds))
+		for wordId, initialWordId := range initialWordIds {
+			var translation *types.Translation
+			if t, ok := translationsByWordId[initialWordId]; ok {
+				translation = &t
+			}
+			words[strconv.FormatUint(uint64(wordId), 10)] = translation
+		}
+		res.Translations[language] = words
+	}
+	return res, nil
+}
+
+func (engine *engineImpl) GetTranslationHistory(ctx context.Context, translationId string) (types.GetTranslationHistoryResponse, error) {
+	revisions, err := engine.dbAccessor.GetTranslationHistory(ctx, translationId)
+	if err != nil {
+		return types.GetTranslationHistoryResponse{}, err
+	}
+	if revisions == nil {
+		revisions = []types.TranslationRevision{}
+	}
+	return types.GetTranslationHistoryResponse{
+		Revisions: revisions,
+	}, nil
+}
+
+// GetAddressTranslations returns pages of translations of the address starting from the latest one, only the token of
+// the next page is returned
+func (engine *engineImpl) GetAddressTranslations(ctx context.Context, address string, continuationToken string) (types.GetAddressTranslationsResponse, PageTokens, error) {
+	afterId, err := engine.tokenCodec.DecodeAddress(continuationToken, address, continuation.AddressTranslationsOrder)
+	if err != nil {
+		return types.GetAddressTranslationsResponse{}, PageTokens{}, err
+	}
+	translations, hasMore, err := engine.dbAccessor.GetAddressTranslations(ctx, address, afterId, engine.itemsLimit, engine.scoring)
+	if err != nil {
+		return types.GetAddressTranslationsResponse{}, PageTokens{}, err
+	}
+	if translations == nil {
+		translations = []types.ContributedTranslation{}
+	}
+	var tokens PageTokens
+	if hasMore && len(translations) > 0 {
+		lastId, _ := strconv.Atoi(translations[len(translations)-1].Id)
+		tokens.Next = engine.tokenCodec.EncodeAddress(address, continuation.AddressTranslationsOrder, lastId)
+	}
+	return types.GetAddressTranslationsResponse{
+		Translations: translations,
+	}, tokens, nil
+}
+
+// GetAddressVotes returns pages of votes of the address starting from the vote for the latest translation, only the
+// token of the next page is returned
+func (engine *engineImpl) GetAddressVotes(ctx context.Context, address string, continuationToken string) (types.GetAddressVotesResponse, PageTokens, error) {
+	afterTranslationId, err := engine.tokenCodec.DecodeAddress(continuationToken, address, continuation.AddressVotesOrder)
+	if err != nil {
+		return types.GetAddressVotesResponse{}, PageTokens{}, err
+	}
+	votes, hasMore, err := engine.dbAccessor.GetAddressVotes(ctx, address, afterTranslationId, engine.itemsLimit, engine.scoring)
+	if err != nil {
+		return types.GetAddressVotesResponse{}, PageTokens{}, err
+	}
+	if votes == nil {
+		votes = []types.AddressVote{}
+	}
+	var tokens PageTokens
+	if hasMore && len(votes) > 0 {
+		lastId, _ := strconv.Atoi(votes[len(votes)-1].Translation.Id)
+		tokens.Next = engine.tokenCodec.EncodeAddress(address, continuation.AddressVotesOrder, lastId)
+	}
+	return types.GetAddressVotesResponse{
+		Votes: votes,
+	}, tokens, nil
+}
+
+func (engine *engineImpl) GetNodeStatus(ctx context.Context) (types.GetNodeStatusResponse, error) {
+	res := types.GetNodeStatusResponse{
+		Nodes: []types.NodeStatus{},
+	}
+	for _, endpoint := range engine.nodeClient.Endpoints() {
+		status := types.NodeStatus{
+			Url:                 endpoint.Url,
+			Healthy:             endpoint.Healthy,
+			Breaker:             endpoint.Breaker,
+			ConsecutiveFailures: endpoint.ConsecutiveFailures,
+			LastEpoch:           endpoint.LastEpoch,
+			LastError:           endpoint.LastError,
+		}
+		if !endpoint.LastCheck.IsZero() {
+			status.LastCheck = endpoint.LastCheck.UTC().Format(time.RFC3339)
+		}
+		res.Nodes = append(res.Nodes, status)
+	}
+	if cache, ok := engine.nodeClient.(node.CacheStatsSource); ok {
+		stats := cache.Stats()
+		res.IdentityCache = &types.IdentityCacheStats{
+			Hits:   stats.Hits,
+			Misses: stats.Misses,
+		}
+	}
+	return res, nil
+}
+
+func (engine *engineImpl) Report(ctx context.Context, request types.ReportRequest) (res types.ReportResponse, err error) {
+	if err := request.Validate(); err != nil {
+		return types.ReportResponse{}, &types.BadRequestError{
+			Message: err.Error(),
+		}
+	}
+	signed, retryAfter, err := engine.acceptSignedRequest(ctx, "report", "report", request.Timestamp, request.Signature, func() (string, error) {
+		return engine.signingFormat.ReportValue(request)
+	})
+	if err != nil {
+		if translationError, ok := err.(*types.TranslationError); ok {
+			return types.ReportResponse{
+				ResCode:    translationError.Code(),
+				Error:      translationError.Error(),
+				RetryAfter: retryAfter,
+			}, nil
+		}
+		return types.ReportResponse{}, err
+	}
+	defer func() {
+		if err != nil {
+			engine.requestGuard.Forget(signed.key)
+		}
+	}()
+	identity, err := engine.nodeClient.GetIdentity(ctx, signed.address)
+	if err != nil {
+		return types.ReportResponse{}, err
+	}
+	if !identity.IsValidated() {
+		return types.ReportResponse{
+			ResCode: types.NotIdentityError.Code(),
+			Error:   types.NotIdentityError.Error(),
+		}, nil
+	}
+	var hidden bool
+	if hidden, err = engine.dbAccessor.Report(ctx, signed.address, request.TranslationId, request.Reason, signed.timestamp, engine.hideThreshold); err != nil {
+		if translationError, ok := err.(*types.TranslationError); ok {
+			return types.ReportResponse{
+				ResCode: translationError.Code(),
+				Error:   translationError.Error(),
+			}, nil
+		}
+		return types.ReportResponse{}, err
+	}
+	return types.ReportResponse{
+		ResCode: types.SuccessResCode,
+		Hidden:  hidden,
+	}, nil
+}
+
+func (engine *engineImpl) GetModerationQueue(ctx context.Context) (types.GetModerationQueueResponse, error) {
+	res, err := engine.dbAccessor.GetModerationQueue(ctx)
+	if err != nil {
+		return types.GetModerationQueueResponse{}, err
+	}
+	if res == nil {
+		res = []types.ModeratedTranslation{}
+	}
+	return types.GetModerationQueueResponse{
+		Translations: res,
+	}, nil
+}
+
+func (engine *engineImpl) RestoreTranslation(ctx context.Context, translationId string) (types.ModeratedTranslation, error) {
+	return engine.moderateTranslation(ctx, translationId, db.VisibleStatus)
+}
+
+func (engine *engineImpl) RemoveTranslation(ctx context.Context, translationId string) (types.ModeratedTranslation, error) {
+	return engine.moderateTranslation(ctx, translationId, db.RemovedStatus)
+}
+
+func (engine *engineImpl) moderateTranslation(ctx context.Context, translationId string, status string) (types.ModeratedTranslation, error) {
+	res, err := engine.dbAccessor.ModerateTranslation(ctx, translationId, status)
+	if err != nil {
+		return types.ModeratedTranslation{}, err
+	}
+	if res == nil {
+		return types.ModeratedTranslation{}, &types.BadRequestError{
+			Message: "unknown translation",
+		}
+	}
+	return *res, nil
+}
+
+func (engine *engineImpl) GetLanguages(ctx context.Context) (types.GetLanguagesResponse, error) {
+	res, err := engine.dbAccessor.GetLanguageCoverage(ctx, engine.scoring)
+	if err != nil {
+		return types.GetLanguagesResponse{}, err
+	}
+	if res == nil {
+		res = []types.LanguageCoverage{}
+	}
+	if wordsCount := engine.wordsMapper.WordsCount(); wordsCount > 0 {
+		for i := range res {
+			coverage := coveragePercent(res[i].ConfirmedWords, wordsCount)
+			res[i].Coverage = &coverage
+		}
+	}
+	return types.GetLanguagesResponse{
+		Languages: res,
+	}, nil
+}
+
+// coveragePercent returns the percentage rounded to hundredths, words missing in the loaded list can be translated,
+// so the result is capped at 100
+func coveragePercent(confirmedWords, wordsCount int) float64 {
+	return math.Min(math.Round(float64(confirmedWords)*10000/float64(wordsCount))/100, 100)
+}
+
+func (engine *engineImpl) AddLanguage(ctx context.Context, request types.AddLanguageRequest) (types.Language, error) {
+	language, err := languages.Canonicalize(request.Language)
+	if err != nil {
+		return types.Language{}, err
+	}
+	if err := engine.dbAccessor.AddLanguage(ctx, language); err != nil {
+		return types.Language{}, err
+	}
+	return types.Language{
+		Name:    language,
+		Enabled: true,
+	}, nil
+}
+
+func (engine *engineImpl) DisableLanguage(ctx context.Context, language string) (types.Language, error) {
+	language, err := languages.Canonicalize(language)
+	if err != nil {
+		return types.Language{}, err
+	}
+	found, err := engine.dbAccessor.DisableLanguage(ctx, language)
+	if err != nil {
+		return types.Language{}, err
+	}
+	if !found {
+		return types.Language{}, &types.BadRequestError{
+			Message: "unknown language",
+		}
+	}
+	return types.Language{
+		Name:    language,
+		Enabled: false,
+	}, nil
+}
+
+func (engine *engineImpl) ExportConfirmedTranslations(ctx context.Context, language string, format string, w io.Writer) error {
+	return engine.exporter.ExportConfirmedTranslations(ctx, language, format, w)
+}
+
+func (engine *engineImpl) ImportTranslations(ctx context.Context, rows []types.ImportTranslationRow, source string, dryRun bool) (types.ImportTranslationsResponse, error) {
+	return engine.importer.ImportTranslations(ctx, rows, source, dryRun)
+}
@@ -0,0 +1,165 @@
+package export
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"github.com/idena-network/idena-translation/types"
+	"io"
+	"strconv"
+	"strings"
+)
+
+const (
+	JsonFormat = "json"
+	CsvFormat  = "csv"
+	PoFormat   = "po"
+)
+
+// Item is a confirmed translation of the word together with the source word if it is known
+type Item struct {
+	WordId            uint32
+	Name              string
+	Description       string
+	SourceName        string
+	SourceDescription string
+}
+
+type Writer interface {
+	Write(item Item) error
+	// Close completes the document, it doesn't close the underlying io.Writer
+	Close() error
+}
+
+// ContentType returns the http content type of the format, ok is false if the format is not supported
+func ContentType(format string) (contentType string, ok bool) {
+	switch format {
+	case JsonFormat:
+		return "application/json", true
+	case CsvFormat:
+		return "text/csv; charset=utf-8", true
+	case PoFormat:
+		return "text/x-gettext-translation; charset=utf-8", true
+	default:
+		return "", false
+	}
+}
+
+func NewWriter(format string, language string, w io.Writer) (Writer, error) {
+	switch format {
+	case JsonFormat:
+		return &jsonWriter{w: w}, nil
+	case CsvFormat:
+		return newCsvWriter(w)
+	case PoFormat:
+		return newPoWriter(w, language)
+	default:
+		return nil, &types.BadRequestError{
+			Message: "invalid value 'format'",
+		}
+	}
+}
+
+// jsonWriter writes a json object keyed by word id
+type jsonWriter struct {
+	w       io.Writer
+	started bool
+}
+
+type jsonItem struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+func (jw *jsonWriter) Write(item Item) error {
+	value, err := json.Marshal(jsonItem{
+		Name:        item.Name,
+		Description: item.Description,
+	})
+	if err != nil {
+		return err
+	}
+	prefix := ","
+	if !jw.started {
+		prefix = "{"
+		jw.started = true
+	}
+	_, err = fmt.Fprintf(jw.w, "%v%q:%s", prefix, strconv.FormatUint(uint64(item.WordId), 10), value)
+	return err
+}
+
+func (jw *jsonWriter) Close() error {
+	if !jw.started {
+		_, err := io.WriteString(jw.w, "{}")
+		return err
+	}
+	_, err := io.WriteString(jw.w, "}")
+	return err
+}
+
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func newCsvWriter(w io.Writer) (*csvWriter, error) {
+	cw := &csvWriter{
+		w: csv.NewWriter(w),
+	}
+	if err := cw.w.Write([]string{"word", "name", "description"}); err != nil {
+		return nil, err
+	}
+	return cw, nil
+}
+
+func (cw *csvWriter) Write(item Item) error {
+	return cw.w.Write([]string{strconv.FormatUint(uint64(item.WordId), 10), item.Name, item.Description})
+}
+
+func (cw *csvWriter) Close() error {
+	cw.w.Flush()
+	return cw.w.Error()
+}
+
+// poWriter writes gettext catalog with two messages per word, the name and the description. Message context is
+// "<word id>/name" or "<word id>/desc", message id is the source word if it is known and the context otherwise.
+type poWriter struct {
+	w io.Writer
+}
+
+func newPoWriter(w io.Writer, language string) (*poWriter, error) {
+	header := "msgid \"\"\nmsgstr \"\"\n" +
+		poString("Language: "+language+"\n") + "\n" +
+		poString("MIME-Version: 1.0\n") + "\n" +
+		poString("Content-Type: text/plain; charset=UTF-8\n") + "\n" +
+		poString("Content-Transfer-Encoding: 8bit\n") + "\n"
+	if _, err := io.WriteString(w, header); err != nil {
+		return nil, err
+	}
+	return &poWriter{w: w}, nil
+}
+
+func (pw *poWriter) Write(item Item) error {
+	wordId := strconv.FormatUint(uint64(item.WordId), 10)
+	if err := pw.writeMessage(wordId+"/name", item.SourceName, item.Name); err != nil {
+		return err
+	}
+	return pw.writeMessage(wordId+"/desc", item.SourceDescription, item.Description)
+}
+
+func (pw *poWriter) writeMessage(context, id, str string) error {
+	if len(id) == 0 {
+		id = context
+	}
+	_, err := fmt.Fprintf(pw.w, "\nmsgctxt %v\nmsgid %v\nmsgstr %v\n", poString(context), poString(id), poString(str))
+	return err
+}
+
+func (pw *poWriter) Close() error {
+	return nil
+}
+
+var poReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\t", `\t`, "\r", `\r`)
+
+func poString(s string) string {
+	return `"` + poReplacer.Replace(s) + `"`
+}
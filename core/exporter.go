@@ -0,0 +1,58 @@
+package core
+
+import (
+	"context"
+	"github.com/idena-network/idena-translation/core/export"
+	"github.com/idena-network/idena-translation/core/languages"
+	"github.com/idena-network/idena-translation/core/words_mapper"
+	"github.com/idena-network/idena-translation/db"
+	"github.com/idena-network/idena-translation/types"
+	"io"
+)
+
+// Exporter writes confirmed translations, it needs no node so the export command does not start the engine
+type Exporter struct {
+	dbAccessor  db.Accessor
+	wordsMapper words_mapper.WordsMapper
+	scoring     db.Scoring
+}
+
+func NewExporter(dbAccessor db.Accessor, wordsMapper words_mapper.WordsMapper, scoring db.Scoring) *Exporter {
+	return &Exporter{
+		dbAccessor:  dbAccessor,
+		wordsMapper: wordsMapper,
+		scoring:     scoring,
+	}
+}
+
+// ExportConfirmedTranslations writes the confirmed translation of every word of the language, duplicated words get
+// the translation of their initial word
+func (e *Exporter) ExportConfirmedTranslations(ctx context.Context, language string, format string, w io.Writer) error {
+	language, err := languages.Canonicalize(language)
+	if err != nil {
+		return err
+	}
+	writer, err := export.NewWriter(format, language, w)
+	if err != nil {
+		return err
+	}
+	err = e.dbAccessor.GetConfirmedTranslations(ctx, language, e.scoring, func(initialWordId uint32, translation types.Translation) error {
+		for _, wordId := range e.wordsMapper.GetWordIds(initialWordId) {
+			sourceName, sourceDescription, _ := e.wordsMapper.GetWord(wordId)
+			if err := writer.Write(export.Item{
+				WordId:            wordId,
+				Name:              translation.Name,
+				Description:       translation.Description,
+				SourceName:        sourceName,
+				SourceDescription: sourceDescription,
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return writer.Close()
+}
@@ -0,0 +1,94 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"github.com/idena-network/idena-translation/core/languages"
+	"github.com/idena-network/idena-translation/core/words_mapper"
+	"github.com/idena-network/idena-translation/db"
+	"github.com/idena-network/idena-translation/types"
+	"regexp"
+	"strings"
+)
+
+// Importer imports translations in bulk, it needs no node so the import command does not start the engine
+type Importer struct {
+	dbAccessor  db.Accessor
+	wordsMapper words_mapper.WordsMapper
+}
+
+func NewImporter(dbAccessor db.Accessor, wordsMapper words_mapper.WordsMapper) *Importer {
+	return &Importer{
+		dbAccessor:  dbAccessor,
+		wordsMapper: wordsMapper,
+	}
+}
+
+var importSourceRegexp = regexp.MustCompile(`^[a-zA-Z0-9_.-]{1,32}$`)
+
+// ImportTranslations validates the rows and imports the valid ones, row numbers of errors and conflicts are indexes
+// of the given rows
+func (importer *Importer) ImportTranslations(ctx context.Context, rows []types.ImportTranslationRow, source string, dryRun bool) (types.ImportTranslationsResponse, error) {
+	if !importSourceRegexp.MatchString(source) {
+		return types.ImportTranslationsResponse{}, &types.BadRequestError{
+			Message: "invalid value 'source'",
+		}
+	}
+	dicLanguages, err := importer.dbAccessor.GetLanguages(ctx)
+	if err != nil {
+		return types.ImportTranslationsResponse{}, err
+	}
+	knownLanguages := make(map[string]struct{}, len(dicLanguages))
+	for _, l := range dicLanguages {
+		knownLanguages[strings.ToLower(l.Name)] = struct{}{}
+	}
+	res := types.ImportTranslationsResponse{
+		DryRun:    dryRun,
+		Conflicts: []types.ImportConflict{},
+		Errors:    []types.ImportRowError{},
+	}
+	var validRows []types.ImportTranslationRow
+	var validRowNumbers []int
+	keys := make(map[string]struct{}, len(rows))
+	for i, row := range rows {
+		if err := row.Validate(); err != nil {
+			res.Errors = append(res.Errors, types.ImportRowError{Row: i, Error: err.Error()})
+			continue
+		}
+		language, err := languages.Canonicalize(row.Language)
+		if err != nil {
+			res.Errors = append(res.Errors, types.ImportRowError{Row: i, Error: err.Error()})
+			continue
+		}
+		if _, ok := knownLanguages[strings.ToLower(language)]; !ok {
+			res.Errors = append(res.Errors, types.ImportRowError{Row: i, Error: "unknown language"})
+			continue
+		}
+		row.Language = language
+		row.Word = importer.wordsMapper.GetInitialWordId(row.Word)
+		if len(row.Address) == 0 {
+			row.Address = "import:" + source
+		}
+		key := fmt.Sprintf("%d|%v|%v", row.Word, strings.ToLower(row.Address), strings.ToLower(row.Language))
+		if _, ok := keys[key]; ok {
+			res.Errors = append(res.Errors, types.ImportRowError{Row: i, Error: "duplicated row"})
+			continue
+		}
+		keys[key] = struct{}{}
+		validRows = append(validRows, row)
+		validRowNumbers = append(validRowNumbers, i)
+	}
+	if len(validRows) == 0 {
+		return res, nil
+	}
+	conflicts, err := importer.dbAccessor.ImportTranslations(ctx, validRows, source, dryRun)
+	if err != nil {
+		return types.ImportTranslationsResponse{}, err
+	}
+	for _, conflict := range conflicts {
+		conflict.Row = validRowNumbers[conflict.Row]
+		res.Conflicts = append(res.Conflicts, conflict)
+	}
+	res.Imported = len(validRows) - len(conflicts)
+	return res, nil
+}
@@ -0,0 +1,104 @@
+package importer
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"github.com/idena-network/idena-translation/core/export"
+	"github.com/idena-network/idena-translation/types"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ReadRows parses json array of types.ImportTranslationRow or csv with header, csv columns are word, name, description
+// and optional language, address and upVotes, so files produced by the csv export can be imported as is.
+// Rows without language get defaultLanguage.
+func ReadRows(format string, r io.Reader, defaultLanguage string) ([]types.ImportTranslationRow, error) {
+	var rows []types.ImportTranslationRow
+	var err error
+	switch format {
+	case export.JsonFormat:
+		rows, err = readJsonRows(r)
+	case export.CsvFormat:
+		rows, err = readCsvRows(r)
+	default:
+		return nil, &types.BadRequestError{
+			Message: "invalid value 'format'",
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		if len(rows[i].Language) == 0 {
+			rows[i].Language = defaultLanguage
+		}
+	}
+	return rows, nil
+}
+
+func readJsonRows(r io.Reader) ([]types.ImportTranslationRow, error) {
+	var rows []types.ImportTranslationRow
+	if err := json.NewDecoder(r).Decode(&rows); err != nil {
+		return nil, &types.BadRequestError{
+			Message: fmt.Sprintf("unable to parse json: %v", err),
+		}
+	}
+	return rows, nil
+}
+
+func readCsvRows(r io.Reader) ([]types.ImportTranslationRow, error) {
+	records, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, &types.BadRequestError{
+			Message: fmt.Sprintf("unable to parse csv: %v", err),
+		}
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+	columns := make(map[string]int)
+	for i, name := range records[0] {
+		columns[strings.TrimSpace(name)] = i
+	}
+	for _, name := range []string{"word", "name"} {
+		if _, ok := columns[name]; !ok {
+			return nil, &types.BadRequestError{
+				Message: fmt.Sprintf("csv column '%v' is missing", name),
+			}
+		}
+	}
+	value := func(record []string, name string) string {
+		if i, ok := columns[name]; ok {
+			return record[i]
+		}
+		return ""
+	}
+	rows := make([]types.ImportTranslationRow, 0, len(records)-1)
+	for i, record := range records[1:] {
+		word, err := strconv.ParseUint(value(record, "word"), 10, 32)
+		if err != nil {
+			return nil, &types.BadRequestError{
+				Message: fmt.Sprintf("row %d: invalid value 'word'", i),
+			}
+		}
+		var upVotes int
+		if s := value(record, "upVotes"); len(s) > 0 {
+			if upVotes, err = strconv.Atoi(s); err != nil {
+				return nil, &types.BadRequestError{
+					Message: fmt.Sprintf("row %d: invalid value 'upVotes'", i),
+				}
+			}
+		}
+		rows = append(rows, types.ImportTranslationRow{
+			Word:        uint32(word),
+			Language:    value(record, "language"),
+			Name:        value(record, "name"),
+			Description: value(record, "description"),
+			Address:     value(record, "address"),
+			UpVotes:     upVotes,
+		})
+	}
+	return rows, nil
+}
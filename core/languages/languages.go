@@ -0,0 +1,32 @@
+package languages
+
+import (
+	"github.com/idena-network/idena-translation/types"
+	"golang.org/x/text/language"
+	"strings"
+)
+
+// MaxTagLength is the maximum length of a language tag, it matches the size of dic_languages.name
+const MaxTagLength = 35
+
+var invalidLanguage = &types.BadRequestError{
+	Message: "invalid value 'language'",
+}
+
+// Canonicalize parses BCP-47 language tag and returns its canonical form, e.g. "pt-br" -> "pt-BR",
+// "zh-hant" -> "zh-Hant", "iw" -> "he".
+func Canonicalize(tag string) (string, error) {
+	tag = strings.TrimSpace(tag)
+	if len(tag) == 0 || len(tag) > MaxTagLength {
+		return "", invalidLanguage
+	}
+	t, err := language.Parse(tag)
+	if err != nil {
+		return "", invalidLanguage
+	}
+	res := t.String()
+	if res == language.Und.String() || len(res) > MaxTagLength {
+		return "", invalidLanguage
+	}
+	return res, nil
+}
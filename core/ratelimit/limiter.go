@@ -0,0 +1,70 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"github.com/idena-network/idena-translation/db"
+	log "github.com/inconshreveable/log15"
+	"strings"
+	"time"
+)
+
+type ipKey struct{}
+
+// WithIp returns the context of the request sent from the ip
+func WithIp(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, ipKey{}, ip)
+}
+
+// Ip returns the client ip of the request or an empty string if it is unknown
+func Ip(ctx context.Context) string {
+	ip, _ := ctx.Value(ipKey{}).(string)
+	return ip
+}
+
+// RouteLimits are limits of the route by the recovered address and by the client ip, a limit with not positive rate
+// is disabled
+type RouteLimits struct {
+	Address db.RateLimit
+	Ip      db.RateLimit
+}
+
+// Limiter applies token bucket limits to routes by the swagger operation id
+type Limiter struct {
+	store  db.RateLimitStore
+	routes map[string]RouteLimits
+	now    func() time.Time
+}
+
+// NewLimiter creates the limiter, routes missing in the map are not limited
+func NewLimiter(store db.RateLimitStore, routes map[string]RouteLimits) *Limiter {
+	return &Limiter{
+		store:  store,
+		routes: routes,
+		now:    time.Now,
+	}
+}
+
+// AllowIp returns 0 if the request from the ip is allowed or the time after which it can be repeated otherwise
+func (l *Limiter) AllowIp(ctx context.Context, route, ip string) time.Duration {
+	return l.allow(ctx, route, "ip", ip, l.routes[route].Ip)
+}
+
+// AllowAddress returns 0 if the request signed by the address is allowed or the time after which it can be repeated
+// otherwise
+func (l *Limiter) AllowAddress(ctx context.Context, route, address string) time.Duration {
+	return l.allow(ctx, route, "address", strings.ToLower(address), l.routes[route].Address)
+}
+
+// allow lets the request through if the store fails, so the api is available while the limits are not
+func (l *Limiter) allow(ctx context.Context, route, kind, value string, limit db.RateLimit) time.Duration {
+	if l == nil || limit.Rate <= 0 || len(value) == 0 {
+		return 0
+	}
+	retryAfter, err := l.store.TakeRateLimitToken(ctx, strings.Join([]string{route, kind, value}, ":"), limit, l.now())
+	if err != nil {
+		log.Warn(fmt.Sprintf("Unable to apply %v rate limit of %v: %v", kind, route, err))
+		return 0
+	}
+	return retryAfter
+}
@@ -0,0 +1,77 @@
+package replay
+
+import (
+	"github.com/idena-network/idena-translation/types"
+	"sync"
+	"time"
+)
+
+// Guard accepts signed requests whose timestamps are within the window around server time and remembers accepted
+// requests until their timestamps leave the window, so that exact replays are rejected. Requests are remembered in
+// memory, so replays are only detected within the instance.
+type Guard struct {
+	window      time.Duration
+	now         func() time.Time
+	mutex       sync.Mutex
+	seen        map[string]time.Time
+	lastCleanup time.Time
+}
+
+// NewGuard creates the guard, all requests are accepted if the window is not positive
+func NewGuard(window time.Duration) *Guard {
+	return &Guard{
+		window: window,
+		now:    time.Now,
+		seen:   make(map[string]time.Time),
+	}
+}
+
+// CheckTimestamp returns types.TimestampOutOfWindowError if the timestamp is too far from server time
+func (g *Guard) CheckTimestamp(timestamp time.Time) error {
+	if g.window <= 0 {
+		return nil
+	}
+	now := g.now()
+	if timestamp.Before(now.Add(-g.window)) || timestamp.After(now.Add(g.window)) {
+		return types.TimestampOutOfWindowError
+	}
+	return nil
+}
+
+// Remember returns types.ReplayedRequestError if the request with the key was accepted before, key has to identify
+// the signed content and the signer
+func (g *Guard) Remember(key string, timestamp time.Time) error {
+	if g.window <= 0 {
+		return nil
+	}
+	now := g.now()
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	if now.Sub(g.lastCleanup) > g.window {
+		g.removeExpired(now)
+		g.lastCleanup = now
+	}
+	if expiresAt, ok := g.seen[key]; ok && now.Before(expiresAt) {
+		return types.ReplayedRequestError
+	}
+	g.seen[key] = timestamp.Add(g.window)
+	return nil
+}
+
+// Forget drops the request, so it can be sent again, e.g. if it failed because of an internal error
+func (g *Guard) Forget(key string) {
+	if g.window <= 0 {
+		return
+	}
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	delete(g.seen, key)
+}
+
+func (g *Guard) removeExpired(now time.Time) {
+	for key, expiresAt := range g.seen {
+		if !now.Before(expiresAt) {
+			delete(g.seen, key)
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"github.com/idena-network/idena-translation/db"
+	"github.com/idena-network/idena-translation/node"
+	log "github.com/inconshreveable/log15"
+	"github.com/pkg/errors"
+	"sync"
+	"time"
+)
+
+// VoteWeights are weights of votes by identity state, states missing in the map have weight 1
+type VoteWeights map[string]float64
+
+func (w VoteWeights) Weight(identity node.Identity) float64 {
+	if weight, ok := w[identity.State]; ok {
+		return weight
+	}
+	return 1
+}
+
+// Revalidator applies identity states of the new epoch to votes and translations, so votes of identities that failed
+// the validation are not counted and translations of such identities are not confirmed
+type Revalidator struct {
+	dbAccessor  db.Accessor
+	nodeClient  node.Client
+	scoring     db.Scoring
+	voteWeights VoteWeights
+	concurrency int
+}
+
+// NewRevalidator creates the revalidator, nodeClient must not cache identities since states are requested right after
+// the epoch change, concurrency limits the number of simultaneous identity requests
+func NewRevalidator(dbAccessor db.Accessor, nodeClient node.Client, scoring db.Scoring, voteWeights VoteWeights, concurrency int) *Revalidator {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	return &Revalidator{
+		dbAccessor:  dbAccessor,
+		nodeClient:  nodeClient,
+		scoring:     scoring,
+		voteWeights: voteWeights,
+		concurrency: concurrency,
+	}
+}
+
+// Start checks the epoch every checkInterval and revalidates the epoch once it is not revalidated yet
+func (r *Revalidator) Start(checkInterval, timeout time.Duration) {
+	go r.loop(checkInterval, timeout)
+}
+
+func (r *Revalidator) loop(checkInterval, timeout time.Duration) {
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+	for {
+		if err := r.check(timeout); err != nil {
+			log.Error(fmt.Sprintf("Unable to revalidate votes: %v", err))
+		}
+		<-ticker.C
+	}
+}
+
+func (r *Revalidator) check(timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	epoch, err := r.nodeClient.LastEpoch(ctx)
+	if err != nil {
+		return errors.Wrap(err, "unable to get last epoch")
+	}
+	revalidatedEpoch, err := r.dbAccessor.LastRevalidatedEpoch(ctx)
+	if err != nil {
+		return err
+	}
+	if revalidatedEpoch != nil && *revalidatedEpoch >= epoch {
+		return nil
+	}
+	log.Info(fmt.Sprintf("Revalidating votes at epoch %v", epoch))
+	res, err := r.Revalidate(ctx, epoch)
+	if err != nil {
+		return err
+	}
+	log.Info(fmt.Sprintf("Votes revalidated at epoch %v, changed votes: %v, translations: %v, confirmations: %v",
+		epoch, res.ChangedVotes, res.ChangedTranslations, res.ChangedConfirmations))
+	return nil
+}
+
+// Revalidate requests current states of all voters and translation authors and applies them to votes and translations,
+// votes and translations of participants whose states are not received are not changed
+func (r *Revalidator) Revalidate(ctx context.Context, epoch uint16) (db.RevalidationResult, error) {
+	addresses, err := r.dbAccessor.GetParticipants(ctx)
+	if err != nil {
+		return db.RevalidationResult{}, err
+	}
+	states, err := r.getStates(ctx, addresses)
+	if err != nil {
+		return db.RevalidationResult{}, err
+	}
+	return r.dbAccessor.Revalidate(ctx, epoch, states, r.scoring)
+}
+
+// getStates requests identities by concurrency requests at once, failed requests are skipped unless the context is done
+func (r *Revalidator) getStates(ctx context.Context, addresses []string) (map[string]db.ParticipantState, error) {
+	states := make(map[string]db.ParticipantState, len(addresses))
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, r.concurrency)
+	for _, address := range addresses {
+		select {
+		case semaphore <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		wg.Add(1)
+		go func(address string) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+			identity, err := r.nodeClient.GetIdentity(ctx, address)
+			if err != nil {
+				if ctx.Err() == nil {
+					log.Warn(fmt.Sprintf("Unable to get identity %v, its votes and translations are not revalidated: %v", address, err))
+				}
+				return
+			}
+			mutex.Lock()
+			defer mutex.Unlock()
+			states[address] = db.ParticipantState{
+				State:    identity.State,
+				Eligible: identity.IsValidated(),
+				Weight:   r.voteWeights.Weight(identity),
+			}
+		}(address)
+	}
+	wg.Wait()
+	if err := ctx.Err(); err != nil {
+		return nil, errors.Wrap(err, "unable to get identities")
+	}
+	return states, nil
+}
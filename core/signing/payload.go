@@ -0,0 +1,95 @@
+package signing
+
+import (
+	"fmt"
+	"github.com/idena-network/idena-translation/types"
+	"strconv"
+	"strings"
+)
+
+const (
+	// LegacyVersion is the concatenation of request fields without separators, requests without version use it
+	LegacyVersion = 1
+	// Version2 is the sequence of length-prefixed fields starting with the service, version, network and request type
+	Version2 = 2
+
+	// Service is the domain tag of the service in version 2 payloads
+	Service = "idena-translation"
+
+	submitTranslationType = "submitTranslation"
+	voteType              = "vote"
+	reportType            = "report"
+	retractVoteType       = "retractVote"
+)
+
+// Format builds values that are signed by clients, a signature of version 2 is only valid for the network
+type Format struct {
+	Network string
+	// AcceptLegacy allows requests signed in the legacy format, it is supposed to be disabled once clients migrate
+	AcceptLegacy bool
+}
+
+// SubmitTranslationValue returns the signed value of the request according to its version
+func (f Format) SubmitTranslationValue(request types.SubmitTranslationRequest) (string, error) {
+	fields := []string{fmt.Sprint(request.Word), request.Language, request.Name, request.Description, request.Timestamp}
+	return f.value(request.Version, submitTranslationType, fields)
+}
+
+// VoteValue returns the signed value of the request according to its version
+func (f Format) VoteValue(request types.VoteRequest) (string, error) {
+	fields := []string{request.TranslationId, fmt.Sprint(request.Up), request.Timestamp}
+	return f.value(request.Version, voteType, fields)
+}
+
+// ReportValue returns the signed value of the request, reports did not exist before version 2, so only version 2 is
+// accepted
+func (f Format) ReportValue(request types.ReportRequest) (string, error) {
+	if request.Version != Version2 {
+		return "", &types.BadRequestError{
+			Message: "invalid value 'version'",
+		}
+	}
+	fields := []string{request.TranslationId, request.Reason, request.Timestamp}
+	return f.value(request.Version, reportType, fields)
+}
+
+// RetractVoteValue returns the signed value of the request, retractions did not exist before version 2, so only version
+// 2 is accepted
+func (f Format) RetractVoteValue(request types.RetractVoteRequest) (string, error) {
+	if request.Version != Version2 {
+		return "", &types.BadRequestError{
+			Message: "invalid value 'version'",
+		}
+	}
+	fields := []string{request.TranslationId, request.Timestamp}
+	return f.value(request.Version, retractVoteType, fields)
+}
+
+func (f Format) value(version uint8, requestType string, fields []string) (string, error) {
+	switch version {
+	case 0, LegacyVersion:
+		if !f.AcceptLegacy {
+			return "", &types.BadRequestError{
+				Message: fmt.Sprintf("signature format version %v is not accepted, use version %v", LegacyVersion, Version2),
+			}
+		}
+		return strings.Join(fields, ""), nil
+	case Version2:
+		var sb strings.Builder
+		for _, field := range append([]string{Service, strconv.Itoa(Version2), f.Network, requestType}, fields...) {
+			writeField(&sb, field)
+		}
+		return sb.String(), nil
+	default:
+		return "", &types.BadRequestError{
+			Message: "invalid value 'version'",
+		}
+	}
+}
+
+// writeField writes the length of the field in bytes, a colon and the field, so fields can't be shifted into each other
+func writeField(sb *strings.Builder, field string) {
+	sb.WriteString(strconv.Itoa(len(field)))
+	sb.WriteByte(':')
+	sb.WriteString(field)
+}
@@ -7,26 +7,48 @@ import (
 	"github.com/pkg/errors"
 	"io/ioutil"
 	"net/http"
+	"sort"
 	"time"
 )
 
 type WordsMapper interface {
 	GetInitialWordId(wordId uint32) uint32
+	// GetWordIds returns the initial word id followed by ids of all the words that are mapped to it
+	GetWordIds(initialWordId uint32) []uint32
+	// GetWord returns the name and the description of the word, ok is false if words are not loaded
+	GetWord(wordId uint32) (name, desc string, ok bool)
+	// WordsCount returns the number of distinct words of the loaded list, duplicates are counted once as they share
+	// translations of the initial word
+	WordsCount() int
 }
 
 func NewWordsMapper(wordsUrl string) WordsMapper {
-	initialWordIdsByWordId, err := initInitialWordIdsByWordId(wordsUrl)
+	wordsList, err := loadWords(wordsUrl)
 	if err != nil {
 		panic(err)
 	}
+	initialWordIdsByWordId := initInitialWordIdsByWordId(wordsList)
 	log.Info("Words mapper initialized", "size", len(initialWordIdsByWordId))
+	duplicatedWordIdsByInitialWordId := make(map[uint32][]uint32)
+	for wordId, initialWordId := range initialWordIdsByWordId {
+		duplicatedWordIdsByInitialWordId[initialWordId] = append(duplicatedWordIdsByInitialWordId[initialWordId], wordId)
+	}
+	for _, wordIds := range duplicatedWordIdsByInitialWordId {
+		sort.Slice(wordIds, func(i, j int) bool {
+			return wordIds[i] < wordIds[j]
+		})
+	}
 	return &wordsMapperImpl{
-		initialWordIdsByWordId: initialWordIdsByWordId,
+		words:                            wordsList,
+		initialWordIdsByWordId:           initialWordIdsByWordId,
+		duplicatedWordIdsByInitialWordId: duplicatedWordIdsByInitialWordId,
 	}
 }
 
 type wordsMapperImpl struct {
-	initialWordIdsByWordId map[uint32]uint32
+	words                            []word
+	initialWordIdsByWordId           map[uint32]uint32
+	duplicatedWordIdsByInitialWordId map[uint32][]uint32
 }
 
 func (wordsMapper *wordsMapperImpl) GetInitialWordId(wordId uint32) uint32 {
@@ -36,6 +58,22 @@ func (wordsMapper *wordsMapperImpl) GetInitialWordId(wordId uint32) uint32 {
 	return wordId
 }
 
+func (wordsMapper *wordsMapperImpl) GetWordIds(initialWordId uint32) []uint32 {
+	return append([]uint32{initialWordId}, wordsMapper.duplicatedWordIdsByInitialWordId[initialWordId]...)
+}
+
+func (wordsMapper *wordsMapperImpl) WordsCount() int {
+	return len(wordsMapper.words) - len(wordsMapper.initialWordIdsByWordId)
+}
+
+func (wordsMapper *wordsMapperImpl) GetWord(wordId uint32) (name, desc string, ok bool) {
+	if int(wordId) >= len(wordsMapper.words) {
+		return "", "", false
+	}
+	w := wordsMapper.words[wordId]
+	return w.Name, w.Desc, true
+}
+
 type words struct {
 	Words []word `json:"words"`
 }
@@ -50,7 +88,7 @@ func (w word) key() string {
 	return string(data)
 }
 
-func initInitialWordIdsByWordId(wordsUrl string) (map[uint32]uint32, error) {
+func loadWords(wordsUrl string) ([]word, error) {
 	if len(wordsUrl) == 0 {
 		return nil, nil
 	}
@@ -62,9 +100,13 @@ func initInitialWordIdsByWordId(wordsUrl string) (map[uint32]uint32, error) {
 	if err := json.Unmarshal(wordsBytes, &wordsData); err != nil {
 		return nil, errors.Wrap(err, "unable to deserialize words")
 	}
-	res := make(map[uint32]uint32, len(wordsData.Words))
+	return wordsData.Words, nil
+}
+
+func initInitialWordIdsByWordId(wordsList []word) map[uint32]uint32 {
+	res := make(map[uint32]uint32, len(wordsList))
 	firstIndexes := make(map[string]uint32)
-	for i, word := range wordsData.Words {
+	for i, word := range wordsList {
 		key := word.key()
 		if firstIndex, ok := firstIndexes[key]; ok {
 			res[uint32(i)] = firstIndex
@@ -72,7 +114,7 @@ func initInitialWordIdsByWordId(wordsUrl string) (map[uint32]uint32, error) {
 			firstIndexes[key] = uint32(i)
 		}
 	}
-	return res, nil
+	return res
 }
 
 func sendRequest(req string) ([]byte, error) {
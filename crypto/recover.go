@@ -0,0 +1,92 @@
+package crypto
+
+import (
+	"encoding/hex"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/sha3"
+	"strings"
+)
+
+const (
+	SignatureLength = 65
+	AddressLength   = 20
+)
+
+// Ecrecover returns the uncompressed public key without 0x04 prefix that created the signature of the hash,
+// the signature is [R || S || V] where V is the recovery id 0 or 1 like in the Idena node
+func Ecrecover(hash []byte, signature []byte) ([]byte, error) {
+	if len(hash) != 32 {
+		return nil, errors.New("invalid hash length")
+	}
+	if len(signature) != SignatureLength {
+		return nil, errors.New("invalid signature length")
+	}
+	recId := signature[64]
+	if recId > 3 {
+		return nil, errors.New("invalid signature recovery id")
+	}
+	// The compact signature is [27 + V || R || S] for the uncompressed public key
+	compact := make([]byte, SignatureLength)
+	compact[0] = 27 + recId
+	copy(compact[1:], signature[:64])
+	pubKey, _, err := ecdsa.RecoverCompact(compact, hash)
+	if err != nil {
+		return nil, errors.New("invalid signature")
+	}
+	return pubKey.SerializeUncompressed()[1:], nil
+}
+
+// Keccak256 is the legacy Keccak-256 hash used by the Idena node
+func Keccak256(data ...[]byte) []byte {
+	h := sha3.NewLegacyKeccak256()
+	for _, b := range data {
+		h.Write(b)
+	}
+	return h.Sum(nil)
+}
+
+// PubKeyToAddress returns the checksummed hex address of the public key returned by Ecrecover
+func PubKeyToAddress(pubKey []byte) string {
+	return checksumHex(Keccak256(pubKey)[32-AddressLength:])
+}
+
+// SignatureHash is the hash of the value signed by the Idena node and wallets
+func SignatureHash(value string) []byte {
+	return Keccak256(Keccak256([]byte(value)))
+}
+
+// SignatureAddress recovers the address that signed the value, the signature is hex with optional 0x prefix
+func SignatureAddress(value string, signatureHex string) (string, error) {
+	signature, err := hex.DecodeString(strings.TrimPrefix(signatureHex, "0x"))
+	if err != nil {
+		return "", errors.New("invalid signature hex")
+	}
+	pubKey, err := Ecrecover(SignatureHash(value), signature)
+	if err != nil {
+		return "", err
+	}
+	return PubKeyToAddress(pubKey), nil
+}
+
+// checksumHex is the EIP-55 mixed-case hex encoding that the node uses for addresses
+func checksumHex(address []byte) string {
+	lower := hex.EncodeToString(address)
+	hash := Keccak256([]byte(lower))
+	res := []byte(lower)
+	for i, c := range res {
+		if c < 'a' {
+			continue
+		}
+		hashByte := hash[i/2]
+		if i%2 == 0 {
+			hashByte >>= 4
+		} else {
+			hashByte &= 0xf
+		}
+		if hashByte > 7 {
+			res[i] = c - 32
+		}
+	}
+	return "0x" + string(res)
+}
@@ -1,13 +1,205 @@
 package db
 
 import (
+	"context"
 	"github.com/idena-network/idena-translation/types"
+	"math"
 	"time"
 )
 
 type Accessor interface {
-	SubmitTranslation(address string, wordId uint32, language string, name string, description string, timestamp time.Time, confirmedRate uint8) (*string, error)
-	GetTranslations(wordId uint32, language string, continuationToken string, limit uint8, confirmedRate uint8) ([]types.Translation, string, error)
-	Vote(address string, translationId string, up bool, timestamp time.Time) (int, int, error)
-	GetConfirmedTranslation(wordId uint32, language string, confirmedRate uint8) (*types.Translation, error)
+	SubmitTranslation(ctx context.Context, address string, wordId uint32, language string, name string, description string, timestamp time.Time, scoring Scoring) (*string, error)
+	// GetTranslations returns the page of translations next to the cursor, or the first page if the cursor is nil,
+	// hasMore is true if there are more translations in the direction of the cursor, translations have the vote state of
+	// the address if it is not empty
+	GetTranslations(ctx context.Context, wordId uint32, language string, cursor *TranslationsCursor, limit uint8, scoring Scoring, address string) (translations []types.Translation, hasMore bool, err error)
+	CountTranslations(ctx context.Context, wordId uint32, language string) (int, error)
+	// Vote counts the vote with the weight, the weight of the changed vote is replaced with the new one, hidden and
+	// removed translations can't be voted for
+	Vote(ctx context.Context, address string, translationId string, up bool, weight float64, timestamp time.Time) (VoteCounts, error)
+	// RetractVote subtracts the vote of the address from the counts of the translation, the retracted vote is kept with
+	// the timestamp of the retraction, so votes signed before the retraction are outdated
+	RetractVote(ctx context.Context, address string, translationId string, timestamp time.Time) (VoteCounts, error)
+	// GetConfirmedTranslation returns the confirmed translation with the vote state of the address if it is not empty
+	GetConfirmedTranslation(ctx context.Context, wordId uint32, language string, scoring Scoring, address string) (*types.Translation, error)
+	// GetConfirmedTranslationsByWords returns confirmed translations of the words by lowercased language and word id,
+	// words without confirmed translation are missing in the result
+	GetConfirmedTranslationsByWords(ctx context.Context, wordIds []uint32, languages []string, scoring Scoring) (map[string]map[uint32]types.Translation, error)
+	// GetConfirmedTranslations calls handler for the confirmed translation of every word of the language in order of word id
+	GetConfirmedTranslations(ctx context.Context, language string, scoring Scoring, handler func(wordId uint32, translation types.Translation) error) error
+	// GetTranslationHistory returns replaced revisions of the translation, the history of hidden and removed
+	// translations is empty
+	GetTranslationHistory(ctx context.Context, translationId string) ([]types.TranslationRevision, error)
+	// GetAddressTranslations returns translations of the address by id descending starting after afterId, 0 means the
+	// first page
+	GetAddressTranslations(ctx context.Context, address string, afterId int, limit uint8, scoring Scoring) (translations []types.ContributedTranslation, hasMore bool, err error)
+	// GetAddressVotes returns votes of the address by translation id descending starting after afterTranslationId, 0
+	// means the first page
+	GetAddressVotes(ctx context.Context, address string, afterTranslationId int, limit uint8, scoring Scoring) (votes []types.AddressVote, hasMore bool, err error)
+	GetLanguages(ctx context.Context) ([]types.Language, error)
+	// GetLanguageCoverage returns languages with numbers of translated and confirmed words, coverage is not set
+	GetLanguageCoverage(ctx context.Context, scoring Scoring) ([]types.LanguageCoverage, error)
+	AddLanguage(ctx context.Context, language string) error
+	DisableLanguage(ctx context.Context, language string) (bool, error)
+	// ImportTranslations inserts rows that don't conflict with existing translations and returns conflicts,
+	// ImportConflict.Row is the index of the row, nothing is inserted if dryRun is true
+	ImportTranslations(ctx context.Context, rows []types.ImportTranslationRow, source string, dryRun bool) ([]types.ImportConflict, error)
+	// LastRevalidatedEpoch returns nil if there was no revalidation
+	LastRevalidatedEpoch(ctx context.Context) (*uint16, error)
+	// GetParticipants returns lowercase addresses of all voters and translation authors, authors of imported
+	// translations are not participants
+	GetParticipants(ctx context.Context) ([]string, error)
+	// Revalidate applies states of participants at the epoch to votes and translations and records the changes,
+	// states are keyed by lowercase address, votes and translations of addresses missing in states are not changed,
+	// authors of imported translations are not revalidated
+	Revalidate(ctx context.Context, epoch uint16, states map[string]ParticipantState, scoring Scoring) (RevalidationResult, error)
+	// Report records the report of the address, the translation is hidden once it has hideThreshold unresolved reports,
+	// hidden is true if the translation is hidden after the report
+	Report(ctx context.Context, address string, translationId string, reason string, timestamp time.Time, hideThreshold int) (hidden bool, err error)
+	// GetModerationQueue returns hidden translations with their unresolved reports
+	GetModerationQueue(ctx context.Context) ([]types.ModeratedTranslation, error)
+	// ModerateTranslation sets the status of the translation and resolves its reports, it returns nil if there is no
+	// such translation or the translation is removed
+	ModerateTranslation(ctx context.Context, translationId string, status string) (*types.ModeratedTranslation, error)
+	RateLimitStore
+}
+
+// Moderation statuses of translations, only visible translations are listed and confirmed
+const (
+	VisibleStatus = "visible"
+	HiddenStatus  = "hidden"
+	RemovedStatus = "removed"
+)
+
+// Vote states of the address passed with translation requests, excluded votes are not counted so they are returned as
+// NoVote
+const (
+	UpVote   = "up"
+	DownVote = "down"
+	NoVote   = "none"
+)
+
+// RateLimitStore keeps token buckets of rate limits
+type RateLimitStore interface {
+	// TakeRateLimitToken takes a token from the bucket of the key, it returns 0 if the token is taken or the time after
+	// which the bucket will have a token otherwise
+	TakeRateLimitToken(ctx context.Context, key string, limit RateLimit, now time.Time) (time.Duration, error)
+}
+
+// RateLimit is the token bucket that holds up to Burst tokens and is refilled with Rate tokens per second
+type RateLimit struct {
+	Rate  float64
+	Burst int
+}
+
+// RefillRateLimitBucket returns tokens of the bucket that had tokens at updatedAt
+func RefillRateLimitBucket(tokens float64, updatedAt, now time.Time, limit RateLimit) float64 {
+	if elapsed := now.Sub(updatedAt).Seconds(); elapsed > 0 {
+		tokens += elapsed * limit.Rate
+	}
+	return math.Min(tokens, float64(limit.Burst))
+}
+
+// TakeRateLimitToken takes a token from the refilled bucket, it returns tokens left in the bucket and 0 if the token
+// is taken or the time after which the bucket will have a token otherwise
+func TakeRateLimitToken(tokens float64, limit RateLimit) (float64, time.Duration) {
+	if tokens >= 1 {
+		return tokens - 1, 0
+	}
+	return tokens, time.Duration((1 - tokens) / limit.Rate * float64(time.Second))
+}
+
+// Scoring defines the rate of translations that is used for sorting and confirmation
+type Scoring struct {
+	ConfirmedRate uint8
+	// Weighted means the rate is the difference of weighted votes, otherwise it is the difference of votes
+	Weighted bool
+}
+
+// Rate returns the rate of the translation according to the scoring
+func (s Scoring) Rate(translation types.Translation) float64 {
+	if s.Weighted {
+		return translation.WeightedUpVotes - translation.WeightedDownVotes
+	}
+	return float64(translation.UpVotes - translation.DownVotes)
+}
+
+// IsConfirmed returns true if the rate of the translation reaches the confirmed rate, translations of excluded authors
+// are not confirmed
+func (s Scoring) IsConfirmed(translation types.Translation, authorExcluded bool) bool {
+	return !authorExcluded && s.Rate(translation) >= float64(s.ConfirmedRate)
+}
+
+type VoteCounts struct {
+	UpVotes           int
+	DownVotes         int
+	WeightedUpVotes   float64
+	WeightedDownVotes float64
+}
+
+// ParticipantState is the identity state of the voter or the translation author at the revalidated epoch. Votes of
+// ineligible participants are excluded from counters and translations of ineligible authors are not confirmed.
+type ParticipantState struct {
+	State    string
+	Eligible bool
+	Weight   float64
+}
+
+// RevalidateVote returns the weight and the exclusion of the vote of the participant, the weight of the excluded vote
+// is kept. Weights are rounded to the precision of the db.
+func (s ParticipantState) RevalidateVote(weight float64, excluded bool) (newWeight float64, newExcluded bool, changed bool) {
+	newWeight, newExcluded = weight, !s.Eligible
+	if s.Eligible {
+		newWeight = math.Round(s.Weight*10000) / 10000
+	}
+	return newWeight, newExcluded, newWeight != weight || newExcluded != excluded
+}
+
+// RevalidatedVoteDelta returns the change of translation counters after the vote revalidation
+func RevalidatedVoteDelta(up bool, prevWeight float64, prevExcluded bool, weight float64, excluded bool) VoteCounts {
+	var res VoteCounts
+	count := func(weight float64, excluded bool, sign int) {
+		if excluded {
+			return
+		}
+		if up {
+			res.UpVotes += sign
+			res.WeightedUpVotes += float64(sign) * weight
+		} else {
+			res.DownVotes += sign
+			res.WeightedDownVotes += float64(sign) * weight
+		}
+	}
+	count(prevWeight, prevExcluded, -1)
+	count(weight, excluded, 1)
+	return res
+}
+
+type RevalidationResult struct {
+	ChangedVotes         int
+	ChangedTranslations  int
+	ChangedConfirmations int
+}
+
+// TranslationsCursor points to the translation in the list of translations of the word sorted by rate descending and
+// then by id, the page starts right after the translation or, if the cursor is backward, ends right before it
+type TranslationsCursor struct {
+	Id       int
+	Rate     float64
+	Backward bool
+}
+
+// TrimPage cuts the extra item of the page requested with limit+1 items, hasMore is true if there are more items in
+// the direction of the cursor. Backward pages are requested in reversed order, so they are reversed back.
+func TrimPage(items []types.Translation, limit uint8, backward bool) (page []types.Translation, hasMore bool) {
+	if len(items) > int(limit) {
+		items = items[:limit]
+		hasMore = true
+	}
+	if backward {
+		for i, j := 0, len(items)-1; i < j; i, j = i+1, j-1 {
+			items[i], items[j] = items[j], items[i]
+		}
+	}
+	return items, hasMore
 }
@@ -0,0 +1,84 @@
+package memory
+
+import (
+	"context"
+	"github.com/idena-network/idena-translation/db"
+	"github.com/idena-network/idena-translation/types"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+func (a *accessor) GetAddressTranslations(ctx context.Context, address string, afterId int, limit uint8, scoring db.Scoring) ([]types.ContributedTranslation, bool, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	var translations []*translation
+	for _, t := range a.translationsById {
+		if strings.EqualFold(t.address, address) && (afterId == 0 || t.id < afterId) {
+			translations = append(translations, t)
+		}
+	}
+	sort.Slice(translations, func(i, j int) bool {
+		return translations[i].id > translations[j].id
+	})
+	hasMore := len(translations) > int(limit)
+	if hasMore {
+		translations = translations[:limit]
+	}
+	res := make([]types.ContributedTranslation, 0, len(translations))
+	for _, t := range translations {
+		res = append(res, a.toContributedTranslation(t, scoring))
+	}
+	return res, hasMore, nil
+}
+
+func (a *accessor) GetAddressVotes(ctx context.Context, address string, afterTranslationId int, limit uint8, scoring db.Scoring) ([]types.AddressVote, bool, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	key := strings.ToLower(address)
+	var translationIds []int
+	for translationId, votes := range a.votesByTranslation {
+		if v, ok := votes[key]; ok && !v.retracted && (afterTranslationId == 0 || translationId < afterTranslationId) {
+			translationIds = append(translationIds, translationId)
+		}
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(translationIds)))
+	hasMore := len(translationIds) > int(limit)
+	if hasMore {
+		translationIds = translationIds[:limit]
+	}
+	res := make([]types.AddressVote, 0, len(translationIds))
+	for _, translationId := range translationIds {
+		v := a.votesByTranslation[translationId][key]
+		res = append(res, types.AddressVote{
+			Up:          v.up,
+			Weight:      v.weight,
+			Excluded:    v.excluded,
+			Timestamp:   v.reqTimestamp.UTC().Format(time.RFC3339),
+			Translation: a.toContributedTranslation(a.translationsById[translationId], scoring),
+		})
+	}
+	return res, hasMore, nil
+}
+
+func (a *accessor) toContributedTranslation(t *translation, scoring db.Scoring) types.ContributedTranslation {
+	var name, description string
+	if t.status == db.VisibleStatus {
+		name, description = t.name, t.description
+	}
+	return types.ContributedTranslation{
+		Id:                strconv.Itoa(t.id),
+		Word:              t.wordId,
+		Language:          a.languages[t.languageId-1].name,
+		Name:              name,
+		Description:       description,
+		UpVotes:           t.upVotes,
+		DownVotes:         t.downVotes,
+		WeightedUpVotes:   t.weightedUpVotes,
+		WeightedDownVotes: t.weightedDownVotes,
+		Confirmed:         t.confirmed(scoring),
+		Status:            t.status,
+		Timestamp:         t.reqTimestamp.UTC().Format(time.RFC3339),
+	}
+}
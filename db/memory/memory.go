@@ -0,0 +1,724 @@
+package memory
+
+import (
+	"context"
+	"github.com/idena-network/idena-translation/db"
+	"github.com/idena-network/idena-translation/types"
+	"github.com/pkg/errors"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Languages that are inserted into dic_languages by init.sql
+var defaultLanguages = []string{
+	"id", "fr", "de", "es", "ru", "zh", "ko", "hr", "hi", "uk",
+	"sr", "ro", "it", "pt", "pl", "sl", "tr", "bg", "sv", "ja",
+}
+
+type translation struct {
+	id                int
+	wordId            uint32
+	address           string
+	languageId        int
+	name              string
+	description       string
+	reqTimestamp      time.Time
+	upVotes           int
+	downVotes         int
+	weightedUpVotes   float64
+	weightedDownVotes float64
+	authorExcluded    bool
+	source            string
+	status            string
+}
+
+func (t *translation) rate(scoring db.Scoring) float64 {
+	if scoring.Weighted {
+		return t.weightedUpVotes - t.weightedDownVotes
+	}
+	return float64(t.upVotes - t.downVotes)
+}
+
+func (t *translation) confirmed(scoring db.Scoring) bool {
+	return !t.authorExcluded && t.rate(scoring) >= float64(scoring.ConfirmedRate)
+}
+
+// revalidatedAuthor returns false for imported translations, their authors are not identities of the network
+func (t *translation) revalidatedAuthor() bool {
+	return len(t.address) > 0 && len(t.source) == 0
+}
+
+func (t *translation) counts() db.VoteCounts {
+	return db.VoteCounts{
+		UpVotes:           t.upVotes,
+		DownVotes:         t.downVotes,
+		WeightedUpVotes:   t.weightedUpVotes,
+		WeightedDownVotes: t.weightedDownVotes,
+	}
+}
+
+func (t *translation) toTypesTranslation(scoring db.Scoring) types.Translation {
+	return types.Translation{
+		Id:                strconv.Itoa(t.id),
+		Name:              t.name,
+		Description:       t.description,
+		UpVotes:           t.upVotes,
+		DownVotes:         t.downVotes,
+		WeightedUpVotes:   t.weightedUpVotes,
+		WeightedDownVotes: t.weightedDownVotes,
+		Confirmed:         t.confirmed(scoring),
+	}
+}
+
+type revision struct {
+	translation
+	replacedBy int
+	replacedAt time.Time
+}
+
+type vote struct {
+	up       bool
+	weight   float64
+	excluded bool
+	// retracted vote is not counted, it is kept to reject votes signed before the retraction
+	retracted    bool
+	reqTimestamp time.Time
+}
+
+type dicLanguage struct {
+	id      int
+	name    string
+	enabled bool
+}
+
+type accessor struct {
+	*rateLimitStore
+	mutex              sync.Mutex
+	languages          []*dicLanguage
+	languagesByName    map[string]*dicLanguage
+	lastTranslationId  int
+	translationsById   map[int]*translation
+	votesByTranslation map[int]map[string]*vote
+	// reportsByTranslation are reports of translations by lowercased addresses
+	reportsByTranslation map[int]map[string]*report
+	revisions            []*revision
+	revalidatedEpoch     *uint16
+}
+
+// NewAccessor creates db.Accessor that keeps all the data in memory and follows the same rules as the postgres
+// functions submit_translation, vote and report. It is intended for local development and tests.
+func NewAccessor() db.Accessor {
+	a := &accessor{
+		rateLimitStore:       newRateLimitStore(),
+		languagesByName:      make(map[string]*dicLanguage),
+		translationsById:     make(map[int]*translation),
+		votesByTranslation:   make(map[int]map[string]*vote),
+		reportsByTranslation: make(map[int]map[string]*report),
+	}
+	for _, name := range defaultLanguages {
+		a.addLanguage(name)
+	}
+	return a
+}
+
+func (a *accessor) addLanguage(name string) {
+	if l, ok := a.languagesByName[strings.ToLower(name)]; ok {
+		l.enabled = true
+		return
+	}
+	l := &dicLanguage{
+		id:      len(a.languages) + 1,
+		name:    name,
+		enabled: true,
+	}
+	a.languages = append(a.languages, l)
+	a.languagesByName[strings.ToLower(name)] = l
+}
+
+func (a *accessor) getLanguageId(name string) (int, bool) {
+	if l, ok := a.languagesByName[strings.ToLower(name)]; ok {
+		return l.id, true
+	}
+	return 0, false
+}
+
+func (a *accessor) SubmitTranslation(ctx context.Context, address string, wordId uint32, language string, name string, description string, timestamp time.Time, scoring db.Scoring) (*string, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	l, ok := a.languagesByName[strings.ToLower(language)]
+	if !ok || !l.enabled {
+		return nil, &types.BadRequestError{
+			Message: "invalid value 'language'",
+		}
+	}
+	languageId := l.id
+	var prev *translation
+	for _, t := range a.translationsById {
+		if t.wordId == wordId && t.languageId == languageId && strings.EqualFold(t.address, address) {
+			prev = t
+			break
+		}
+	}
+	if prev != nil {
+		if prev.rate(scoring) >= float64(scoring.ConfirmedRate) {
+			return nil, types.ConfirmedTranslationExistsError
+		}
+		if !prev.reqTimestamp.Before(timestamp) {
+			return nil, types.OutdatedSubmissionError
+		}
+	}
+	a.lastTranslationId++
+	// The moderation status and reports are kept, so a hidden or removed translation can't be reset by resubmit
+	status := db.VisibleStatus
+	if prev != nil {
+		status = prev.status
+		if reports, ok := a.reportsByTranslation[prev.id]; ok {
+			a.reportsByTranslation[a.lastTranslationId] = reports
+			delete(a.reportsByTranslation, prev.id)
+		}
+		a.revisions = append(a.revisions, &revision{
+			translation: *prev,
+			replacedBy:  a.lastTranslationId,
+			replacedAt:  time.Now(),
+		})
+		delete(a.votesByTranslation, prev.id)
+		delete(a.translationsById, prev.id)
+	}
+	t := &translation{
+		id:           a.lastTranslationId,
+		wordId:       wordId,
+		address:      address,
+		languageId:   languageId,
+		name:         name,
+		description:  description,
+		reqTimestamp: timestamp,
+		status:       status,
+	}
+	a.translationsById[t.id] = t
+	translationId := strconv.Itoa(t.id)
+	return &translationId, nil
+}
+
+// sortedTranslations returns translations of the word sorted the same way as in getTranslations.sql
+func (a *accessor) sortedTranslations(wordId uint32, languageId int, scoring db.Scoring) []*translation {
+	var res []*translation
+	for _, t := range a.translationsById {
+		if t.wordId == wordId && t.languageId == languageId && t.status == db.VisibleStatus {
+			res = append(res, t)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].rate(scoring) != res[j].rate(scoring) {
+			return res[i].rate(scoring) > res[j].rate(scoring)
+		}
+		return res[i].id < res[j].id
+	})
+	return res
+}
+
+func (a *accessor) GetTranslations(ctx context.Context, wordId uint32, language string, cursor *db.TranslationsCursor, limit uint8, scoring db.Scoring, address string) ([]types.Translation, bool, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	languageId, ok := a.getLanguageId(language)
+	if !ok {
+		return nil, false, nil
+	}
+	sorted := a.sortedTranslations(wordId, languageId, scoring)
+	backward := cursor != nil && cursor.Backward
+	if backward {
+		for i, j := 0, len(sorted)-1; i < j; i, j = i+1, j-1 {
+			sorted[i], sorted[j] = sorted[j], sorted[i]
+		}
+	}
+	var res []types.Translation
+	for _, t := range sorted {
+		if len(res) == int(limit)+1 {
+			break
+		}
+		if cursor != nil && !isAfterCursor(t.rate(scoring), t.id, cursor) {
+			continue
+		}
+		res = append(res, a.toTypesTranslation(t, scoring, address))
+	}
+	res, hasMore := db.TrimPage(res, limit, backward)
+	return res, hasMore, nil
+}
+
+// isAfterCursor returns true if the translation follows the cursor in the direction of the cursor
+func isAfterCursor(rate float64, id int, cursor *db.TranslationsCursor) bool {
+	if cursor.Backward {
+		return rate > cursor.Rate || rate == cursor.Rate && id < cursor.Id
+	}
+	return rate < cursor.Rate || rate == cursor.Rate && id > cursor.Id
+}
+
+func (a *accessor) CountTranslations(ctx context.Context, wordId uint32, language string) (int, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	languageId, ok := a.getLanguageId(language)
+	if !ok {
+		return 0, nil
+	}
+	return len(a.sortedTranslations(wordId, languageId, db.Scoring{})), nil
+}
+
+func (a *accessor) Vote(ctx context.Context, address string, translationId string, up bool, weight float64, timestamp time.Time) (db.VoteCounts, error) {
+	translationIdNum, err := strconv.Atoi(translationId)
+	if err != nil {
+		return db.VoteCounts{}, &types.BadRequestError{
+			Message: "invalid value 'translationId'",
+		}
+	}
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	t, ok := a.translationsById[translationIdNum]
+	// Hidden and removed translations can't be voted for
+	if !ok || t.status != db.VisibleStatus {
+		return db.VoteCounts{}, &types.BadRequestError{
+			Message: "invalid value 'translationId'",
+		}
+	}
+	if t.address == address {
+		return db.VoteCounts{}, types.SelfVotingError
+	}
+	votes := a.votesByTranslation[t.id]
+	if votes == nil {
+		votes = make(map[string]*vote)
+		a.votesByTranslation[t.id] = votes
+	}
+	key := strings.ToLower(address)
+	if v, ok := votes[key]; !ok || v.excluded || v.retracted {
+		// The vote excluded at the revalidation or retracted is not counted, so it is counted again as a new one
+		if ok && !v.reqTimestamp.Before(timestamp) {
+			return db.VoteCounts{}, types.OutdatedSubmissionError
+		}
+		votes[key] = &vote{
+			up:           up,
+			weight:       weight,
+			reqTimestamp: timestamp,
+		}
+		if up {
+			t.upVotes++
+			t.weightedUpVotes += weight
+		} else {
+			t.downVotes++
+			t.weightedDownVotes += weight
+		}
+	} else {
+		if v.up == up {
+			return db.VoteCounts{}, types.DuplicatedVoteError
+		}
+		if !v.reqTimestamp.Before(timestamp) {
+			return db.VoteCounts{}, types.OutdatedSubmissionError
+		}
+		if up {
+			t.upVotes++
+			t.downVotes--
+			t.weightedUpVotes += weight
+			t.weightedDownVotes -= v.weight
+		} else {
+			t.upVotes--
+			t.downVotes++
+			t.weightedUpVotes -= v.weight
+			t.weightedDownVotes += weight
+		}
+		v.up = up
+		v.weight = weight
+		v.reqTimestamp = timestamp
+	}
+	return t.counts(), nil
+}
+
+func (a *accessor) RetractVote(ctx context.Context, address string, translationId string, timestamp time.Time) (db.VoteCounts, error) {
+	translationIdNum, err := strconv.Atoi(translationId)
+	if err != nil {
+		return db.VoteCounts{}, &types.BadRequestError{
+			Message: "invalid value 'translationId'",
+		}
+	}
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	t, ok := a.translationsById[translationIdNum]
+	// Votes for hidden and removed translations can't be retracted
+	if !ok || t.status != db.VisibleStatus {
+		return db.VoteCounts{}, &types.BadRequestError{
+			Message: "invalid value 'translationId'",
+		}
+	}
+	key := strings.ToLower(address)
+	v, ok := a.votesByTranslation[t.id][key]
+	if ok && !v.reqTimestamp.Before(timestamp) {
+		return db.VoteCounts{}, types.OutdatedSubmissionError
+	}
+	// The vote excluded at the revalidation is not counted, so there is nothing to retract
+	if !ok || v.excluded || v.retracted {
+		return db.VoteCounts{}, types.NoVoteError
+	}
+	v.retracted = true
+	v.reqTimestamp = timestamp
+	if v.up {
+		t.upVotes--
+		t.weightedUpVotes -= v.weight
+	} else {
+		t.downVotes--
+		t.weightedDownVotes -= v.weight
+	}
+	return t.counts(), nil
+}
+
+func (a *accessor) GetConfirmedTranslation(ctx context.Context, wordId uint32, language string, scoring db.Scoring, address string) (*types.Translation, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	languageId, ok := a.getLanguageId(language)
+	if !ok {
+		return nil, nil
+	}
+	// The top translation is not confirmed if its author is excluded at the revalidation, the next confirmed one is used
+	for _, t := range a.sortedTranslations(wordId, languageId, scoring) {
+		if t.confirmed(scoring) {
+			res := a.toTypesTranslation(t, scoring, address)
+			return &res, nil
+		}
+	}
+	return nil, nil
+}
+
+// toTypesTranslation converts the translation with the vote state of the address if it is not empty
+func (a *accessor) toTypesTranslation(t *translation, scoring db.Scoring, address string) types.Translation {
+	res := t.toTypesTranslation(scoring)
+	if len(address) == 0 {
+		return res
+	}
+	res.MyVote = db.NoVote
+	if v, ok := a.votesByTranslation[t.id][strings.ToLower(address)]; ok && !v.excluded && !v.retracted {
+		if v.up {
+			res.MyVote = db.UpVote
+		} else {
+			res.MyVote = db.DownVote
+		}
+	}
+	res.IsMine = strings.EqualFold(t.address, address)
+	return res
+}
+
+func (a *accessor) GetTranslationHistory(ctx context.Context, translationId string) ([]types.TranslationRevision, error) {
+	translationIdNum, err := strconv.Atoi(translationId)
+	if err != nil {
+		return nil, &types.BadRequestError{
+			Message: "invalid value 'translationId'",
+		}
+	}
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	key, ok := a.translationsById[translationIdNum]
+	if !ok {
+		for _, r := range a.revisions {
+			if r.id == translationIdNum {
+				key = &r.translation
+				break
+			}
+		}
+	}
+	if key == nil {
+		return nil, nil
+	}
+	// The history of hidden and removed translations is not shown
+	var visible bool
+	for _, t := range a.translationsById {
+		if t.wordId == key.wordId && t.languageId == key.languageId && strings.EqualFold(t.address, key.address) {
+			visible = t.status == db.VisibleStatus
+			break
+		}
+	}
+	if !visible {
+		return nil, nil
+	}
+	var revisions []*revision
+	for _, r := range a.revisions {
+		if r.wordId == key.wordId && r.languageId == key.languageId && strings.EqualFold(r.address, key.address) {
+			revisions = append(revisions, r)
+		}
+	}
+	sort.Slice(revisions, func(i, j int) bool {
+		return revisions[i].reqTimestamp.After(revisions[j].reqTimestamp)
+	})
+	res := make([]types.TranslationRevision, 0, len(revisions))
+	for _, r := range revisions {
+		res = append(res, types.TranslationRevision{
+			Id:          strconv.Itoa(r.id),
+			Name:        r.name,
+			Description: r.description,
+			UpVotes:     r.upVotes,
+			DownVotes:   r.downVotes,
+			Timestamp:   r.reqTimestamp.UTC().Format(time.RFC3339),
+			ReplacedAt:  r.replacedAt.UTC().Format(time.RFC3339),
+		})
+	}
+	return res, nil
+}
+
+func (a *accessor) GetLanguages(ctx context.Context) ([]types.Language, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	res := make([]types.Language, 0, len(a.languages))
+	for _, l := range a.languages {
+		res = append(res, types.Language{
+			Name:    l.name,
+			Enabled: l.enabled,
+		})
+	}
+	return res, nil
+}
+
+func (a *accessor) GetLanguageCoverage(ctx context.Context, scoring db.Scoring) ([]types.LanguageCoverage, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	translatedByLanguageId := make(map[int]map[uint32]bool)
+	confirmedByLanguageId := make(map[int]map[uint32]bool)
+	for _, t := range a.translationsById {
+		if t.status != db.VisibleStatus {
+			continue
+		}
+		if translatedByLanguageId[t.languageId] == nil {
+			translatedByLanguageId[t.languageId] = make(map[uint32]bool)
+			confirmedByLanguageId[t.languageId] = make(map[uint32]bool)
+		}
+		translatedByLanguageId[t.languageId][t.wordId] = true
+		if t.confirmed(scoring) {
+			confirmedByLanguageId[t.languageId][t.wordId] = true
+		}
+	}
+	res := make([]types.LanguageCoverage, 0, len(a.languages))
+	for _, l := range a.languages {
+		res = append(res, types.LanguageCoverage{
+			Name:            l.name,
+			Enabled:         l.enabled,
+			TranslatedWords: len(translatedByLanguageId[l.id]),
+			ConfirmedWords:  len(confirmedByLanguageId[l.id]),
+		})
+	}
+	return res, nil
+}
+
+func (a *accessor) AddLanguage(ctx context.Context, language string) error {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	a.addLanguage(language)
+	return nil
+}
+
+func (a *accessor) DisableLanguage(ctx context.Context, language string) (bool, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	l, ok := a.languagesByName[strings.ToLower(language)]
+	if !ok {
+		return false, nil
+	}
+	l.enabled = false
+	return true, nil
+}
+
+func (a *accessor) GetConfirmedTranslationsByWords(ctx context.Context, wordIds []uint32, languages []string, scoring db.Scoring) (map[string]map[uint32]types.Translation, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	languagesById := make(map[int]string, len(languages))
+	for _, language := range languages {
+		if languageId, ok := a.getLanguageId(language); ok {
+			languagesById[languageId] = strings.ToLower(language)
+		}
+	}
+	requested := make(map[uint32]bool, len(wordIds))
+	for _, wordId := range wordIds {
+		requested[wordId] = true
+	}
+	bestByLanguage := make(map[string]map[uint32]*translation)
+	for _, t := range a.translationsById {
+		language, ok := languagesById[t.languageId]
+		if !ok || !requested[t.wordId] || t.status != db.VisibleStatus || !t.confirmed(scoring) {
+			continue
+		}
+		bestByWordId := bestByLanguage[language]
+		if bestByWordId == nil {
+			bestByWordId = make(map[uint32]*translation)
+			bestByLanguage[language] = bestByWordId
+		}
+		if best, ok := bestByWordId[t.wordId]; !ok || t.rate(scoring) > best.rate(scoring) ||
+			t.rate(scoring) == best.rate(scoring) && t.id < best.id {
+			bestByWordId[t.wordId] = t
+		}
+	}
+	res := make(map[string]map[uint32]types.Translation, len(bestByLanguage))
+	for language, bestByWordId := range bestByLanguage {
+		res[language] = make(map[uint32]types.Translation, len(bestByWordId))
+		for wordId, t := range bestByWordId {
+			res[language][wordId] = t.toTypesTranslation(scoring)
+		}
+	}
+	return res, nil
+}
+
+func (a *accessor) GetConfirmedTranslations(ctx context.Context, language string, scoring db.Scoring, handler func(wordId uint32, translation types.Translation) error) error {
+	a.mutex.Lock()
+	languageId, ok := a.getLanguageId(language)
+	if !ok {
+		a.mutex.Unlock()
+		return nil
+	}
+	bestByWordId := make(map[uint32]*translation)
+	for _, t := range a.translationsById {
+		if t.languageId != languageId || t.status != db.VisibleStatus || !t.confirmed(scoring) {
+			continue
+		}
+		if best, ok := bestByWordId[t.wordId]; !ok || t.rate(scoring) > best.rate(scoring) ||
+			t.rate(scoring) == best.rate(scoring) && t.id < best.id {
+			bestByWordId[t.wordId] = t
+		}
+	}
+	wordIds := make([]uint32, 0, len(bestByWordId))
+	res := make(map[uint32]types.Translation, len(bestByWordId))
+	for wordId, t := range bestByWordId {
+		wordIds = append(wordIds, wordId)
+		res[wordId] = t.toTypesTranslation(scoring)
+	}
+	a.mutex.Unlock()
+	sort.Slice(wordIds, func(i, j int) bool {
+		return wordIds[i] < wordIds[j]
+	})
+	for _, wordId := range wordIds {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := handler(wordId, res[wordId]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *accessor) ImportTranslations(ctx context.Context, rows []types.ImportTranslationRow, source string, dryRun bool) ([]types.ImportConflict, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	var conflicts []types.ImportConflict
+	var toInsert []*translation
+	for i, row := range rows {
+		languageId, ok := a.getLanguageId(row.Language)
+		if !ok {
+			return nil, errors.Errorf("unknown language '%v'", row.Language)
+		}
+		var existing *translation
+		for _, t := range a.translationsById {
+			if t.wordId == row.Word && t.languageId == languageId && strings.EqualFold(t.address, row.Address) {
+				existing = t
+				break
+			}
+		}
+		if existing != nil {
+			conflicts = append(conflicts, types.ImportConflict{
+				Row:           i,
+				Word:          row.Word,
+				Language:      row.Language,
+				Address:       row.Address,
+				TranslationId: strconv.Itoa(existing.id),
+			})
+			continue
+		}
+		toInsert = append(toInsert, &translation{
+			wordId:          row.Word,
+			address:         row.Address,
+			languageId:      languageId,
+			name:            row.Name,
+			description:     row.Description,
+			reqTimestamp:    time.Now(),
+			upVotes:         row.UpVotes,
+			weightedUpVotes: float64(row.UpVotes),
+			source:          source,
+			status:          db.VisibleStatus,
+		})
+	}
+	if dryRun {
+		return conflicts, nil
+	}
+	for _, t := range toInsert {
+		a.lastTranslationId++
+		t.id = a.lastTranslationId
+		a.translationsById[t.id] = t
+	}
+	return conflicts, nil
+}
+
+func (a *accessor) LastRevalidatedEpoch(ctx context.Context) (*uint16, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	return a.revalidatedEpoch, nil
+}
+
+func (a *accessor) GetParticipants(ctx context.Context) ([]string, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	unique := make(map[string]struct{})
+	for _, t := range a.translationsById {
+		if t.revalidatedAuthor() {
+			unique[strings.ToLower(t.address)] = struct{}{}
+		}
+	}
+	for _, votes := range a.votesByTranslation {
+		for address, v := range votes {
+			if !v.retracted {
+				unique[address] = struct{}{}
+			}
+		}
+	}
+	res := make([]string, 0, len(unique))
+	for address := range unique {
+		res = append(res, address)
+	}
+	sort.Strings(res)
+	return res, nil
+}
+
+// Revalidate follows the same rules as the postgres accessor but doesn't keep the records of changes
+func (a *accessor) Revalidate(ctx context.Context, epoch uint16, states map[string]db.ParticipantState, scoring db.Scoring) (db.RevalidationResult, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	var res db.RevalidationResult
+	for _, t := range a.translationsById {
+		prevConfirmed := t.confirmed(scoring)
+		changed := false
+		for address, v := range a.votesByTranslation[t.id] {
+			state, ok := states[address]
+			if !ok || v.retracted {
+				continue
+			}
+			weight, excluded, voteChanged := state.RevalidateVote(v.weight, v.excluded)
+			if !voteChanged {
+				continue
+			}
+			delta := db.RevalidatedVoteDelta(v.up, v.weight, v.excluded, weight, excluded)
+			t.upVotes += delta.UpVotes
+			t.downVotes += delta.DownVotes
+			t.weightedUpVotes += delta.WeightedUpVotes
+			t.weightedDownVotes += delta.WeightedDownVotes
+			v.weight, v.excluded = weight, excluded
+			changed = true
+			res.ChangedVotes++
+		}
+		if state, ok := states[strings.ToLower(t.address)]; ok && t.revalidatedAuthor() && t.authorExcluded == state.Eligible {
+			t.authorExcluded = !state.Eligible
+			changed = true
+		}
+		if !changed {
+			continue
+		}
+		res.ChangedTranslations++
+		if prevConfirmed != t.confirmed(scoring) {
+			res.ChangedConfirmations++
+		}
+	}
+	if a.revalidatedEpoch == nil || *a.revalidatedEpoch < epoch {
+		a.revalidatedEpoch = &epoch
+	}
+	return res, nil
+}
@@ -0,0 +1,134 @@
+package memory
+
+import (
+	"context"
+	"github.com/idena-network/idena-translation/db"
+	"github.com/idena-network/idena-translation/types"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type report struct {
+	reason       string
+	reqTimestamp time.Time
+	resolved     bool
+}
+
+func (a *accessor) Report(ctx context.Context, address string, translationId string, reason string, timestamp time.Time, hideThreshold int) (bool, error) {
+	translationIdNum, err := strconv.Atoi(translationId)
+	if err != nil {
+		return false, &types.BadRequestError{
+			Message: "invalid value 'translationId'",
+		}
+	}
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	t, ok := a.translationsById[translationIdNum]
+	if !ok || t.status == db.RemovedStatus {
+		return false, &types.BadRequestError{
+			Message: "invalid value 'translationId'",
+		}
+	}
+	if strings.EqualFold(t.address, address) {
+		return false, types.SelfReportingError
+	}
+	reports := a.reportsByTranslation[t.id]
+	if reports == nil {
+		reports = make(map[string]*report)
+		a.reportsByTranslation[t.id] = reports
+	}
+	key := strings.ToLower(address)
+	if r, ok := reports[key]; ok && !r.resolved {
+		return false, types.DuplicatedReportError
+	}
+	// The report resolved by the moderator is replaced, so the address can report the translation again
+	reports[key] = &report{
+		reason:       reason,
+		reqTimestamp: timestamp,
+	}
+	if t.status == db.VisibleStatus && hideThreshold > 0 && a.unresolvedReports(t.id) >= hideThreshold {
+		t.status = db.HiddenStatus
+	}
+	return t.status == db.HiddenStatus, nil
+}
+
+func (a *accessor) unresolvedReports(translationId int) int {
+	var res int
+	for _, r := range a.reportsByTranslation[translationId] {
+		if !r.resolved {
+			res++
+		}
+	}
+	return res
+}
+
+func (a *accessor) GetModerationQueue(ctx context.Context) ([]types.ModeratedTranslation, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	var hidden []*translation
+	for _, t := range a.translationsById {
+		if t.status == db.HiddenStatus {
+			hidden = append(hidden, t)
+		}
+	}
+	sort.Slice(hidden, func(i, j int) bool {
+		return hidden[i].id < hidden[j].id
+	})
+	res := make([]types.ModeratedTranslation, 0, len(hidden))
+	for _, t := range hidden {
+		res = append(res, a.toModeratedTranslation(t))
+	}
+	return res, nil
+}
+
+func (a *accessor) ModerateTranslation(ctx context.Context, translationId string, status string) (*types.ModeratedTranslation, error) {
+	translationIdNum, err := strconv.Atoi(translationId)
+	if err != nil {
+		return nil, nil
+	}
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	t, ok := a.translationsById[translationIdNum]
+	if !ok || t.status == db.RemovedStatus {
+		return nil, nil
+	}
+	t.status = status
+	res := a.toModeratedTranslation(t)
+	for _, r := range a.reportsByTranslation[t.id] {
+		r.resolved = true
+	}
+	return &res, nil
+}
+
+// toModeratedTranslation returns the translation with numbers of its unresolved reports sorted by reason the same way
+// as in getModerationQueue.sql
+func (a *accessor) toModeratedTranslation(t *translation) types.ModeratedTranslation {
+	countsByReason := make(map[string]int)
+	for _, r := range a.reportsByTranslation[t.id] {
+		if !r.resolved {
+			countsByReason[r.reason]++
+		}
+	}
+	reports := make([]types.ReportCount, 0, len(countsByReason))
+	for reason, count := range countsByReason {
+		reports = append(reports, types.ReportCount{
+			Reason: reason,
+			Count:  count,
+		})
+	}
+	sort.Slice(reports, func(i, j int) bool {
+		return reports[i].Reason < reports[j].Reason
+	})
+	return types.ModeratedTranslation{
+		Id:          strconv.Itoa(t.id),
+		Word:        t.wordId,
+		Language:    a.languages[t.languageId-1].name,
+		Address:     t.address,
+		Name:        t.name,
+		Description: t.description,
+		Status:      t.status,
+		Reports:     reports,
+	}
+}
@@ -0,0 +1,68 @@
+package memory
+
+import (
+	"context"
+	"github.com/idena-network/idena-translation/db"
+	"sync"
+	"time"
+)
+
+const rateLimitCleanupInterval = time.Minute
+
+type rateLimitBucket struct {
+	tokens    float64
+	updatedAt time.Time
+	limit     db.RateLimit
+}
+
+// rateLimitStore keeps token buckets in memory, so limits are applied within the instance
+type rateLimitStore struct {
+	mutex       sync.Mutex
+	buckets     map[string]*rateLimitBucket
+	lastCleanup time.Time
+}
+
+// NewRateLimitStore creates db.RateLimitStore that keeps token buckets in memory
+func NewRateLimitStore() db.RateLimitStore {
+	return newRateLimitStore()
+}
+
+func newRateLimitStore() *rateLimitStore {
+	return &rateLimitStore{
+		buckets: make(map[string]*rateLimitBucket),
+	}
+}
+
+func (s *rateLimitStore) TakeRateLimitToken(ctx context.Context, key string, limit db.RateLimit, now time.Time) (time.Duration, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if now.Sub(s.lastCleanup) > rateLimitCleanupInterval {
+		s.removeFull(now)
+		s.lastCleanup = now
+	}
+	bucket, ok := s.buckets[key]
+	if !ok {
+		bucket = &rateLimitBucket{
+			tokens:    float64(limit.Burst),
+			updatedAt: now,
+		}
+		s.buckets[key] = bucket
+	}
+	tokens := db.RefillRateLimitBucket(bucket.tokens, bucket.updatedAt, now, limit)
+	var retryAfter time.Duration
+	bucket.tokens, retryAfter = db.TakeRateLimitToken(tokens, limit)
+	bucket.limit = limit
+	if now.After(bucket.updatedAt) {
+		bucket.updatedAt = now
+	}
+	return retryAfter, nil
+}
+
+// removeFull drops buckets that are refilled since a new bucket is full as well
+func (s *rateLimitStore) removeFull(now time.Time) {
+	for key, bucket := range s.buckets {
+		if db.RefillRateLimitBucket(bucket.tokens, bucket.updatedAt, now, bucket.limit) >= float64(bucket.limit.Burst) {
+			delete(s.buckets, key)
+		}
+	}
+}
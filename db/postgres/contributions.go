@@ -0,0 +1,80 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"github.com/idena-network/idena-translation/db"
+	"github.com/idena-network/idena-translation/types"
+	"strconv"
+	"time"
+)
+
+func (a *accessor) GetAddressTranslations(ctx context.Context, address string, afterId int, limit uint8, scoring db.Scoring) ([]types.ContributedTranslation, bool, error) {
+	var res []types.ContributedTranslation
+	err := a.read(ctx, func(sqlDb *sql.DB) error {
+		res = nil
+		rows, err := sqlDb.QueryContext(ctx, a.getQuery(getAddressTranslationsQuery), address, afterId, int(limit)+1,
+			scoring.ConfirmedRate, scoring.Weighted)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var item types.ContributedTranslation
+			var id int
+			var timestamp time.Time
+			if err := rows.Scan(&id, &item.Word, &item.Language, &item.Name, &item.Description, &item.UpVotes,
+				&item.DownVotes, &item.WeightedUpVotes, &item.WeightedDownVotes, &item.Confirmed, &item.Status,
+				&timestamp); err != nil {
+				return err
+			}
+			item.Id = strconv.Itoa(id)
+			item.Timestamp = timestamp.UTC().Format(time.RFC3339)
+			res = append(res, item)
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	if len(res) > int(limit) {
+		return res[:limit], true, nil
+	}
+	return res, false, nil
+}
+
+func (a *accessor) GetAddressVotes(ctx context.Context, address string, afterTranslationId int, limit uint8, scoring db.Scoring) ([]types.AddressVote, bool, error) {
+	var res []types.AddressVote
+	err := a.read(ctx, func(sqlDb *sql.DB) error {
+		res = nil
+		rows, err := sqlDb.QueryContext(ctx, a.getQuery(getAddressVotesQuery), address, afterTranslationId, int(limit)+1,
+			scoring.ConfirmedRate, scoring.Weighted)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var item types.AddressVote
+			var id int
+			var timestamp, translationTimestamp time.Time
+			t := &item.Translation
+			if err := rows.Scan(&item.Up, &item.Weight, &item.Excluded, &timestamp, &id, &t.Word, &t.Language, &t.Name,
+				&t.Description, &t.UpVotes, &t.DownVotes, &t.WeightedUpVotes, &t.WeightedDownVotes, &t.Confirmed,
+				&t.Status, &translationTimestamp); err != nil {
+				return err
+			}
+			item.Timestamp = timestamp.UTC().Format(time.RFC3339)
+			t.Id = strconv.Itoa(id)
+			t.Timestamp = translationTimestamp.UTC().Format(time.RFC3339)
+			res = append(res, item)
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	if len(res) > int(limit) {
+		return res[:limit], true, nil
+	}
+	return res, false, nil
+}
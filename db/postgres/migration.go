@@ -0,0 +1,203 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	log "github.com/inconshreveable/log15"
+	"github.com/pkg/errors"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+)
+
+const (
+	migrationsDir = "migrations"
+	// Key of the advisory lock that prevents concurrent migrations from several instances
+	migrationLockKey = 7342180523
+	// LatestVersion can be passed to Migrate to apply all the available migrations
+	LatestVersion = -1
+
+	createSchemaVersionQuery = `CREATE TABLE IF NOT EXISTS schema_version
+(
+    version    integer     NOT NULL,
+    name       text        NOT NULL,
+    applied_at timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT schema_version_pkey PRIMARY KEY (version)
+)`
+	getSchemaVersionQuery    = "SELECT coalesce(max(version), 0) FROM schema_version"
+	insertSchemaVersionQuery = "INSERT INTO schema_version (version, name) VALUES ($1, $2)"
+	deleteSchemaVersionQuery = "DELETE FROM schema_version WHERE version = $1"
+)
+
+var migrationFileRegexp = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+type migration struct {
+	version int
+	name    string
+	up      string
+	down    string
+}
+
+func readMigrations(scriptsDirPath string) ([]migration, error) {
+	dir := filepath.Join(scriptsDirPath, migrationsDir)
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	migrationsByVersion := make(map[int]*migration)
+	fileNamesByScript := make(map[string]string)
+	for _, file := range files {
+		match := migrationFileRegexp.FindStringSubmatch(file.Name())
+		if match == nil {
+			continue
+		}
+		version, _ := strconv.Atoi(match[1])
+		// Versions with leading zeros such as 1_init.up.sql and 0001_init.up.sql are the same migration
+		scriptKey := fmt.Sprintf("%d.%v", version, match[3])
+		if fileName, ok := fileNamesByScript[scriptKey]; ok {
+			return nil, errors.Errorf("migration %d has several %v scripts: %v, %v", version, match[3], fileName, file.Name())
+		}
+		fileNamesByScript[scriptKey] = file.Name()
+		m, ok := migrationsByVersion[version]
+		if !ok {
+			m = &migration{
+				version: version,
+				name:    match[2],
+			}
+			migrationsByVersion[version] = m
+		}
+		if m.name != match[2] {
+			return nil, errors.Errorf("migration %d has different names: %v, %v", version, m.name, match[2])
+		}
+		bytes, err := ioutil.ReadFile(filepath.Join(dir, file.Name()))
+		if err != nil {
+			return nil, err
+		}
+		if match[3] == "up" {
+			m.up = string(bytes)
+		} else {
+			m.down = string(bytes)
+		}
+	}
+	res := make([]migration, 0, len(migrationsByVersion))
+	for _, m := range migrationsByVersion {
+		if len(m.up) == 0 {
+			return nil, errors.Errorf("migration %d has no up script", m.version)
+		}
+		res = append(res, *m)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].version < res[j].version
+	})
+	for i, m := range res {
+		if m.version != i+1 {
+			return nil, errors.Errorf("migration %d is missing", i+1)
+		}
+	}
+	return res, nil
+}
+
+// Migrate applies up or down migrations from scriptsDirPath/migrations until the schema reaches targetVersion.
+// Concurrent calls from different processes are serialized by a postgres advisory lock.
+func Migrate(connStr string, scriptsDirPath string, targetVersion int) error {
+	sqlDb, err := sql.Open("postgres", connStr)
+	if err != nil {
+		return err
+	}
+	defer sqlDb.Close()
+	return migrate(sqlDb, scriptsDirPath, targetVersion)
+}
+
+// SchemaVersion returns the version of the latest applied migration, 0 if there is no one.
+func SchemaVersion(connStr string) (int, error) {
+	sqlDb, err := sql.Open("postgres", connStr)
+	if err != nil {
+		return 0, err
+	}
+	defer sqlDb.Close()
+	if _, err := sqlDb.Exec(createSchemaVersionQuery); err != nil {
+		return 0, err
+	}
+	var version int
+	err = sqlDb.QueryRow(getSchemaVersionQuery).Scan(&version)
+	return version, err
+}
+
+func migrate(sqlDb *sql.DB, scriptsDirPath string, targetVersion int) error {
+	migrations, err := readMigrations(scriptsDirPath)
+	if err != nil {
+		return errors.Wrap(err, "unable to read migrations")
+	}
+	if targetVersion == LatestVersion {
+		targetVersion = len(migrations)
+	}
+	if targetVersion < 0 || targetVersion > len(migrations) {
+		return errors.Errorf("unknown schema version %d", targetVersion)
+	}
+
+	ctx := context.Background()
+	// Advisory locks belong to a session so the lock and the migrations have to use the same connection
+	conn, err := sqlDb.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", migrationLockKey); err != nil {
+		return errors.Wrap(err, "unable to acquire migration lock")
+	}
+	defer func() {
+		if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_unlock($1)", migrationLockKey); err != nil {
+			log.Error(fmt.Sprintf("Unable to release migration lock: %v", err))
+		}
+	}()
+
+	if _, err := conn.ExecContext(ctx, createSchemaVersionQuery); err != nil {
+		return err
+	}
+	var currentVersion int
+	if err := conn.QueryRowContext(ctx, getSchemaVersionQuery).Scan(&currentVersion); err != nil {
+		return err
+	}
+	if currentVersion > len(migrations) {
+		return errors.Errorf("schema version %d is newer than the latest known migration %d", currentVersion, len(migrations))
+	}
+	for currentVersion < targetVersion {
+		m := migrations[currentVersion]
+		if err := applyMigration(ctx, conn, m.up, insertSchemaVersionQuery, m.version, m.name); err != nil {
+			return errors.Wrapf(err, "unable to apply migration %d_%v", m.version, m.name)
+		}
+		log.Info(fmt.Sprintf("Applied migration %d_%v", m.version, m.name))
+		currentVersion++
+	}
+	for currentVersion > targetVersion {
+		m := migrations[currentVersion-1]
+		if len(m.down) == 0 {
+			return errors.Errorf("migration %d_%v has no down script", m.version, m.name)
+		}
+		if err := applyMigration(ctx, conn, m.down, deleteSchemaVersionQuery, m.version); err != nil {
+			return errors.Wrapf(err, "unable to revert migration %d_%v", m.version, m.name)
+		}
+		log.Info(fmt.Sprintf("Reverted migration %d_%v", m.version, m.name))
+		currentVersion--
+	}
+	return nil
+}
+
+func applyMigration(ctx context.Context, conn *sql.Conn, script string, versionQuery string, versionArgs ...interface{}) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, script); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, versionQuery, versionArgs...); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
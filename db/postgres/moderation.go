@@ -0,0 +1,133 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/idena-network/idena-translation/db"
+	"github.com/idena-network/idena-translation/types"
+	"github.com/pkg/errors"
+	"strconv"
+	"time"
+)
+
+// moderationActions are actions of the moderations table by statuses set by moderators
+var moderationActions = map[string]string{
+	db.VisibleStatus: "restore",
+	db.HiddenStatus:  "hide",
+	db.RemovedStatus: "remove",
+}
+
+func (a *accessor) Report(ctx context.Context, address string, translationId string, reason string, timestamp time.Time, hideThreshold int) (bool, error) {
+	translationIdNum, err := strconv.Atoi(translationId)
+	if err != nil {
+		return false, &types.BadRequestError{
+			Message: "invalid value 'translationId'",
+		}
+	}
+	var resCode int
+	var status string
+	if err := a.db.QueryRowContext(ctx, a.getQuery(reportQuery), address, translationIdNum, reason, timestamp, hideThreshold).
+		Scan(&resCode, &status); err != nil {
+		return false, err
+	}
+	switch resCode {
+	case 0:
+		return status == db.HiddenStatus, nil
+	case -1:
+		return false, &types.BadRequestError{
+			Message: "invalid value 'translationId'",
+		}
+	case 1:
+		return false, types.SelfReportingError
+	case 2:
+		return false, types.DuplicatedReportError
+	default:
+		return false, errors.New(fmt.Sprintf("unknown res code %d", resCode))
+	}
+}
+
+func (a *accessor) GetModerationQueue(ctx context.Context) ([]types.ModeratedTranslation, error) {
+	var res []types.ModeratedTranslation
+	err := a.read(ctx, func(sqlDb *sql.DB) error {
+		rows, err := sqlDb.QueryContext(ctx, a.getQuery(getModerationQueueQuery))
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		res, err = readModeratedTranslations(rows)
+		return err
+	})
+	return res, err
+}
+
+func (a *accessor) ModerateTranslation(ctx context.Context, translationId string, status string) (*types.ModeratedTranslation, error) {
+	translationIdNum, err := strconv.Atoi(translationId)
+	if err != nil {
+		return nil, nil
+	}
+	tx, err := a.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	var id int
+	err = tx.QueryRowContext(ctx, a.getQuery(moderateTranslationQuery), translationIdNum, status).Scan(&id)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	rows, err := tx.QueryContext(ctx, a.getQuery(getModeratedTranslationQuery), translationIdNum)
+	if err != nil {
+		return nil, err
+	}
+	translations, err := readModeratedTranslations(rows)
+	rows.Close()
+	if err != nil {
+		return nil, err
+	}
+	if len(translations) == 0 {
+		return nil, errors.Errorf("translation %v is not found after moderation", translationId)
+	}
+	if _, err := tx.ExecContext(ctx, a.getQuery(resolveReportsQuery), translationIdNum); err != nil {
+		return nil, err
+	}
+	if _, err := tx.ExecContext(ctx, a.getQuery(insertModerationQuery), translationIdNum, moderationActions[status]); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return &translations[0], nil
+}
+
+// readModeratedTranslations reads rows of translations joined with numbers of reports by reason, rows of the same
+// translation go one after another
+func readModeratedTranslations(rows *sql.Rows) ([]types.ModeratedTranslation, error) {
+	var res []types.ModeratedTranslation
+	for rows.Next() {
+		var id int
+		var item types.ModeratedTranslation
+		var reason sql.NullString
+		var count int
+		if err := rows.Scan(&id, &item.Word, &item.Language, &item.Address, &item.Name, &item.Description, &item.Status,
+			&reason, &count); err != nil {
+			return nil, err
+		}
+		item.Id = strconv.Itoa(id)
+		if len(res) == 0 || res[len(res)-1].Id != item.Id {
+			item.Reports = []types.ReportCount{}
+			res = append(res, item)
+		}
+		if reason.Valid {
+			last := &res[len(res)-1]
+			last.Reports = append(last.Reports, types.ReportCount{
+				Reason: reason.String,
+				Count:  count,
+			})
+		}
+	}
+	return res, rows.Err()
+}
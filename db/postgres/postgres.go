@@ -1,13 +1,14 @@
 package postgres
 
 import (
+	"context"
 	"database/sql"
-	"encoding/hex"
 	"fmt"
+	"github.com/idena-network/idena-translation/config"
 	"github.com/idena-network/idena-translation/db"
 	"github.com/idena-network/idena-translation/types"
 	log "github.com/inconshreveable/log15"
-	_ "github.com/lib/pq"
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 	"io/ioutil"
 	"path/filepath"
@@ -17,26 +18,63 @@ import (
 )
 
 const (
-	initQuery                    = "init.sql"
-	submitTranslationQuery       = "submitTranslation.sql"
-	getTranslationsQuery         = "getTranslations.sql"
-	voteQuery                    = "vote.sql"
-	getConfirmedTranslationQuery = "getConfirmedTranslation.sql"
+	submitTranslationQuery               = "submitTranslation.sql"
+	getTranslationsQuery                 = "getTranslations.sql"
+	getTranslationsBackwardQuery         = "getTranslationsBackward.sql"
+	countTranslationsQuery               = "countTranslations.sql"
+	voteQuery                            = "vote.sql"
+	retractVoteQuery                     = "retractVote.sql"
+	getConfirmedTranslationQuery         = "getConfirmedTranslation.sql"
+	getConfirmedTranslationsQuery        = "getConfirmedTranslations.sql"
+	getConfirmedTranslationsByWordsQuery = "getConfirmedTranslationsByWords.sql"
+	getTranslationHistoryQuery           = "getTranslationHistory.sql"
+	getAddressTranslationsQuery          = "getAddressTranslations.sql"
+	getAddressVotesQuery                 = "getAddressVotes.sql"
+	getLanguagesQuery                    = "getLanguages.sql"
+	getLanguageCoverageQuery             = "getLanguageCoverage.sql"
+	addLanguageQuery                     = "addLanguage.sql"
+	disableLanguageQuery                 = "disableLanguage.sql"
+	getTranslationIdQuery                = "getTranslationId.sql"
+	importTranslationQuery               = "importTranslation.sql"
+	getLastRevalidatedEpochQuery         = "getLastRevalidatedEpoch.sql"
+	getParticipantsQuery                 = "getParticipants.sql"
+	getRevalidationVotesQuery            = "getRevalidationVotes.sql"
+	getRevalidationAuthorQuery           = "getRevalidationAuthor.sql"
+	getRevalidationTranslationIdsQuery   = "getRevalidationTranslationIds.sql"
+	revalidateVoteQuery                  = "revalidateVote.sql"
+	insertVoteRevalidationQuery          = "insertVoteRevalidation.sql"
+	revalidateTranslationQuery           = "revalidateTranslation.sql"
+	insertTranslationRevalidationQuery   = "insertTranslationRevalidation.sql"
+	insertRevalidationQuery              = "insertRevalidation.sql"
+	takeRateLimitTokenQuery              = "takeRateLimitToken.sql"
+	deleteFullRateLimitBucketsQuery      = "deleteFullRateLimitBuckets.sql"
+	reportQuery                          = "report.sql"
+	getModerationQueueQuery              = "getModerationQueue.sql"
+	getModeratedTranslationQuery         = "getModeratedTranslation.sql"
+	moderateTranslationQuery             = "moderateTranslation.sql"
+	resolveReportsQuery                  = "resolveReports.sql"
+	insertModerationQuery                = "insertModeration.sql"
 )
 
 type accessor struct {
-	db      *sql.DB
-	queries map[string]string
+	db             *sql.DB
+	replicas       *replicaSet
+	queries        map[string]string
+	scriptsDirPath string
+	// lastRateLimitCleanup is the unix time of the last removal of full rate limit buckets
+	lastRateLimitCleanup int64
 }
 
-func NewAccessor(connStr string, scriptsDirPath string) db.Accessor {
-	sqlDb, err := sql.Open("postgres", connStr)
+// NewAccessor creates the accessor that sends writes to the primary and reads to replicas if they are configured
+func NewAccessor(conf config.PostgresConfig) db.Accessor {
+	sqlDb, err := openDb(conf.ConnStr, conf)
 	if err != nil {
 		panic(err)
 	}
 	a := &accessor{
-		db:      sqlDb,
-		queries: readQueries(scriptsDirPath),
+		db:             sqlDb,
+		queries:        readQueries(conf.ScriptsDir),
+		scriptsDirPath: conf.ScriptsDir,
 	}
 	for {
 		if err := a.init(); err != nil {
@@ -46,6 +84,9 @@ func NewAccessor(connStr string, scriptsDirPath string) db.Accessor {
 		}
 		break
 	}
+	if a.replicas, err = newReplicaSet(conf); err != nil {
+		panic(err)
+	}
 	return a
 }
 
@@ -75,10 +116,45 @@ func (a *accessor) init() error {
 	if err := a.db.Ping(); err != nil {
 		return err
 	}
-	if _, err := a.db.Exec(a.getQuery(initQuery)); err != nil {
+	return migrate(a.db, a.scriptsDirPath, LatestVersion)
+}
+
+// finalError is returned by read queries that can't be repeated on the primary, e.g. the ones that have already
+// passed rows to the caller
+type finalError struct {
+	error
+}
+
+func finalIf(final bool, err error) error {
+	if err == nil || !final {
 		return err
 	}
-	return nil
+	return finalError{err}
+}
+
+// read runs the query on an available replica, the query is repeated on the primary if there is no available replica
+// or the replica fails
+func (a *accessor) read(ctx context.Context, query func(sqlDb *sql.DB) error) error {
+	r := a.replicas.next()
+	if r == nil {
+		return unwrapFinal(query(a.db))
+	}
+	err := query(r.db)
+	if err == nil || err == sql.ErrNoRows || ctx.Err() != nil {
+		return unwrapFinal(err)
+	}
+	if _, ok := err.(finalError); ok {
+		return unwrapFinal(err)
+	}
+	log.Warn(fmt.Sprintf("Read query failed on postgres replica %v, trying primary: %v", r.index, err))
+	return unwrapFinal(query(a.db))
+}
+
+func unwrapFinal(err error) error {
+	if final, ok := err.(finalError); ok {
+		return final.error
+	}
+	return err
 }
 
 func (a *accessor) getQuery(name string) string {
@@ -88,11 +164,11 @@ func (a *accessor) getQuery(name string) string {
 	panic(fmt.Sprintf("There is no query '%s'", name))
 }
 
-func (a *accessor) SubmitTranslation(address string, wordId uint32, language string, name string, description string, timestamp time.Time, confirmedRate uint8) (*string, error) {
+func (a *accessor) SubmitTranslation(ctx context.Context, address string, wordId uint32, language string, name string, description string, timestamp time.Time, scoring db.Scoring) (*string, error) {
 	var resCode int
 	var translationId string
-	if err := a.db.QueryRow(a.getQuery(submitTranslationQuery),
-		address, wordId, language, name, description, timestamp, confirmedRate).Scan(&resCode, &translationId); err != nil {
+	if err := a.db.QueryRowContext(ctx, a.getQuery(submitTranslationQuery),
+		address, wordId, language, name, description, timestamp, scoring.ConfirmedRate, scoring.Weighted).Scan(&resCode, &translationId); err != nil {
 		return nil, err
 	}
 	switch resCode {
@@ -111,106 +187,334 @@ func (a *accessor) SubmitTranslation(address string, wordId uint32, language str
 	}
 }
 
-func (a *accessor) GetTranslations(wordId uint32, language string, continuationToken string, limit uint8, confirmedRate uint8) ([]types.Translation, string, error) {
-	id, rate, err := parseContinuationToken(continuationToken)
-	if err != nil {
-		return nil, "", err
-	}
-	rows, err := a.db.Query(a.getQuery(getTranslationsQuery), wordId, language, rate, id, limit+1, confirmedRate)
-	if err != nil {
-		return nil, "", err
+func (a *accessor) GetTranslations(ctx context.Context, wordId uint32, language string, cursor *db.TranslationsCursor, limit uint8, scoring db.Scoring, address string) ([]types.Translation, bool, error) {
+	query := getTranslationsQuery
+	var id int
+	var rate float64
+	var backward bool
+	if cursor != nil {
+		id, rate, backward = cursor.Id, cursor.Rate, cursor.Backward
+		if backward {
+			query = getTranslationsBackwardQuery
+		}
 	}
-	defer rows.Close()
 	var res []types.Translation
-	for rows.Next() {
-		var item types.Translation
-		err := rows.Scan(&item.Id, &item.Name, &item.Description, &item.UpVotes, &item.DownVotes, &item.Confirmed)
+	err := a.read(ctx, func(sqlDb *sql.DB) error {
+		res = nil
+		rows, err := sqlDb.QueryContext(ctx, a.getQuery(query), wordId, language, rate, id, limit+1, scoring.ConfirmedRate,
+			scoring.Weighted, address)
 		if err != nil {
-			return nil, "", err
+			return err
 		}
-		res = append(res, item)
-	}
-	var nextContinuationToken string
-	if len(res) > 0 && len(res) == int(limit+1) {
-		nextItem := res[len(res)-1]
-		id, _ := strconv.Atoi(nextItem.Id)
-		nextContinuationToken = buildContinuationToken(id, nextItem.UpVotes-nextItem.DownVotes)
-		res = res[:len(res)-1]
+		defer rows.Close()
+		for rows.Next() {
+			var item types.Translation
+			var myVote string
+			var isMine bool
+			err := rows.Scan(&item.Id, &item.Name, &item.Description, &item.UpVotes, &item.DownVotes, &item.WeightedUpVotes,
+				&item.WeightedDownVotes, &item.Confirmed, &myVote, &isMine)
+			if err != nil {
+				return err
+			}
+			setVoteState(&item, address, myVote, isMine)
+			res = append(res, item)
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, false, err
 	}
-	return res, nextContinuationToken, err
-}
-
-func buildContinuationToken(id int, rate int) string {
-	val := strconv.Itoa(id) + "|" + strconv.Itoa(rate)
-	return hex.EncodeToString([]byte(val))
+	res, hasMore := db.TrimPage(res, limit, backward)
+	return res, hasMore, nil
 }
 
-var invalidContinuationToken = &types.BadRequestError{
-	Message: "invalid value 'continuation-token'",
+func (a *accessor) CountTranslations(ctx context.Context, wordId uint32, language string) (int, error) {
+	var res int
+	err := a.read(ctx, func(sqlDb *sql.DB) error {
+		return sqlDb.QueryRowContext(ctx, a.getQuery(countTranslationsQuery), wordId, language).Scan(&res)
+	})
+	return res, err
 }
 
-func parseContinuationToken(token string) (id, rate int, err error) {
-	if len(token) == 0 {
-		return 0, 0, nil
-	}
-	b, err := hex.DecodeString(token)
+func (a *accessor) Vote(ctx context.Context, address string, translationId string, up bool, weight float64, timestamp time.Time) (db.VoteCounts, error) {
+	translationIdNum, err := strconv.Atoi(translationId)
 	if err != nil {
-		return 0, 0, invalidContinuationToken
-	}
-	s := string(b)
-	fields := strings.Split(s, "|")
-	if len(fields) != 2 {
-		return 0, 0, invalidContinuationToken
+		return db.VoteCounts{}, &types.BadRequestError{
+			Message: "invalid value 'translationId'",
+		}
 	}
-	id, err = strconv.Atoi(fields[0])
-	if err != nil {
-		return 0, 0, invalidContinuationToken
+	var resCode int
+	var res db.VoteCounts
+	if err := a.db.QueryRowContext(ctx, a.getQuery(voteQuery), address, translationIdNum, up, timestamp, weight).
+		Scan(&resCode, &res.UpVotes, &res.DownVotes, &res.WeightedUpVotes, &res.WeightedDownVotes); err != nil {
+		return db.VoteCounts{}, err
 	}
-	rate, err = strconv.Atoi(fields[1])
-	if err != nil {
-		return 0, 0, invalidContinuationToken
+	switch resCode {
+	case 0:
+		return res, nil
+	case -1:
+		return db.VoteCounts{}, &types.BadRequestError{
+			Message: "invalid value 'translationId'",
+		}
+	case 1:
+		return db.VoteCounts{}, types.SelfVotingError
+	case 2:
+		return db.VoteCounts{}, types.OutdatedSubmissionError
+	case 3:
+		return db.VoteCounts{}, types.DuplicatedVoteError
+	default:
+		return db.VoteCounts{}, errors.New(fmt.Sprintf("unknown res code %d", resCode))
 	}
-	return
 }
 
-func (a *accessor) Vote(address string, translationId string, up bool, timestamp time.Time) (int, int, error) {
+func (a *accessor) RetractVote(ctx context.Context, address string, translationId string, timestamp time.Time) (db.VoteCounts, error) {
 	translationIdNum, err := strconv.Atoi(translationId)
 	if err != nil {
-		return 0, 0, &types.BadRequestError{
+		return db.VoteCounts{}, &types.BadRequestError{
 			Message: "invalid value 'translationId'",
 		}
 	}
-	var resCode, upVotes, downVotes int
-	if err := a.db.QueryRow(a.getQuery(voteQuery), address, translationIdNum, up, timestamp).Scan(&resCode, &upVotes, &downVotes); err != nil {
-		return 0, 0, err
+	var resCode int
+	var res db.VoteCounts
+	if err := a.db.QueryRowContext(ctx, a.getQuery(retractVoteQuery), address, translationIdNum, timestamp).
+		Scan(&resCode, &res.UpVotes, &res.DownVotes, &res.WeightedUpVotes, &res.WeightedDownVotes); err != nil {
+		return db.VoteCounts{}, err
 	}
 	switch resCode {
 	case 0:
-		return upVotes, downVotes, nil
+		return res, nil
 	case -1:
-		return 0, 0, &types.BadRequestError{
+		return db.VoteCounts{}, &types.BadRequestError{
 			Message: "invalid value 'translationId'",
 		}
-	case 1:
-		return 0, 0, types.SelfVotingError
 	case 2:
-		return 0, 0, types.OutdatedSubmissionError
+		return db.VoteCounts{}, types.OutdatedSubmissionError
 	case 3:
-		return 0, 0, types.DuplicatedVoteError
+		return db.VoteCounts{}, types.NoVoteError
 	default:
-		return 0, 0, errors.New(fmt.Sprintf("unknown res code %d", resCode))
+		return db.VoteCounts{}, errors.New(fmt.Sprintf("unknown res code %d", resCode))
 	}
 }
 
-func (a *accessor) GetConfirmedTranslation(wordId uint32, language string, confirmedRate uint8) (*types.Translation, error) {
+func (a *accessor) GetConfirmedTranslation(ctx context.Context, wordId uint32, language string, scoring db.Scoring, address string) (*types.Translation, error) {
 	res := types.Translation{}
-	err := a.db.QueryRow(a.getQuery(getConfirmedTranslationQuery), wordId, language, confirmedRate).
-		Scan(&res.Id, &res.Name, &res.Description, &res.UpVotes, &res.DownVotes, &res.Confirmed)
+	var myVote string
+	var isMine bool
+	err := a.read(ctx, func(sqlDb *sql.DB) error {
+		return sqlDb.QueryRowContext(ctx, a.getQuery(getConfirmedTranslationQuery), wordId, language, scoring.ConfirmedRate, scoring.Weighted, address).
+			Scan(&res.Id, &res.Name, &res.Description, &res.UpVotes, &res.DownVotes, &res.WeightedUpVotes,
+				&res.WeightedDownVotes, &res.Confirmed, &myVote, &isMine)
+	})
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
 	if err != nil {
 		return nil, err
 	}
+	setVoteState(&res, address, myVote, isMine)
 	return &res, nil
 }
+
+// setVoteState sets the vote state read by the query, the state is only returned if the address is passed
+func setVoteState(translation *types.Translation, address string, myVote string, isMine bool) {
+	if len(address) == 0 {
+		return
+	}
+	translation.MyVote = myVote
+	translation.IsMine = isMine
+}
+
+func (a *accessor) GetConfirmedTranslationsByWords(ctx context.Context, wordIds []uint32, languages []string, scoring db.Scoring) (map[string]map[uint32]types.Translation, error) {
+	wordIdsParam := make([]int64, 0, len(wordIds))
+	for _, wordId := range wordIds {
+		wordIdsParam = append(wordIdsParam, int64(wordId))
+	}
+	languagesParam := make([]string, 0, len(languages))
+	for _, language := range languages {
+		languagesParam = append(languagesParam, strings.ToLower(language))
+	}
+	var res map[string]map[uint32]types.Translation
+	err := a.read(ctx, func(sqlDb *sql.DB) error {
+		res = make(map[string]map[uint32]types.Translation)
+		rows, err := sqlDb.QueryContext(ctx, a.getQuery(getConfirmedTranslationsByWordsQuery), pq.Array(wordIdsParam),
+			pq.Array(languagesParam), scoring.ConfirmedRate, scoring.Weighted)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var language string
+			var wordId uint32
+			var item types.Translation
+			if err := rows.Scan(&language, &wordId, &item.Id, &item.Name, &item.Description, &item.UpVotes, &item.DownVotes,
+				&item.WeightedUpVotes, &item.WeightedDownVotes, &item.Confirmed); err != nil {
+				return err
+			}
+			if res[language] == nil {
+				res[language] = make(map[uint32]types.Translation)
+			}
+			res[language][wordId] = item
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (a *accessor) GetConfirmedTranslations(ctx context.Context, language string, scoring db.Scoring, handler func(wordId uint32, translation types.Translation) error) error {
+	return a.read(ctx, func(sqlDb *sql.DB) error {
+		rows, err := sqlDb.QueryContext(ctx, a.getQuery(getConfirmedTranslationsQuery), language, scoring.ConfirmedRate, scoring.Weighted)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		started := false
+		for rows.Next() {
+			var wordId uint32
+			var item types.Translation
+			if err := rows.Scan(&wordId, &item.Id, &item.Name, &item.Description, &item.UpVotes, &item.DownVotes,
+				&item.WeightedUpVotes, &item.WeightedDownVotes, &item.Confirmed); err != nil {
+				return finalIf(started, err)
+			}
+			started = true
+			if err := handler(wordId, item); err != nil {
+				return finalError{err}
+			}
+		}
+		return finalIf(started, rows.Err())
+	})
+}
+
+func (a *accessor) GetTranslationHistory(ctx context.Context, translationId string) ([]types.TranslationRevision, error) {
+	translationIdNum, err := strconv.Atoi(translationId)
+	if err != nil {
+		return nil, &types.BadRequestError{
+			Message: "invalid value 'translationId'",
+		}
+	}
+	var res []types.TranslationRevision
+	err = a.read(ctx, func(sqlDb *sql.DB) error {
+		res = nil
+		rows, err := sqlDb.QueryContext(ctx, a.getQuery(getTranslationHistoryQuery), translationIdNum)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var item types.TranslationRevision
+			var timestamp, replacedAt time.Time
+			err := rows.Scan(&item.Id, &item.Name, &item.Description, &item.UpVotes, &item.DownVotes, &timestamp, &replacedAt)
+			if err != nil {
+				return err
+			}
+			item.Timestamp = timestamp.UTC().Format(time.RFC3339)
+			item.ReplacedAt = replacedAt.UTC().Format(time.RFC3339)
+			res = append(res, item)
+		}
+		return rows.Err()
+	})
+	return res, err
+}
+
+func (a *accessor) GetLanguages(ctx context.Context) ([]types.Language, error) {
+	var res []types.Language
+	err := a.read(ctx, func(sqlDb *sql.DB) error {
+		res = nil
+		rows, err := sqlDb.QueryContext(ctx, a.getQuery(getLanguagesQuery))
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var item types.Language
+			if err := rows.Scan(&item.Name, &item.Enabled); err != nil {
+				return err
+			}
+			res = append(res, item)
+		}
+		return rows.Err()
+	})
+	return res, err
+}
+
+func (a *accessor) GetLanguageCoverage(ctx context.Context, scoring db.Scoring) ([]types.LanguageCoverage, error) {
+	var res []types.LanguageCoverage
+	err := a.read(ctx, func(sqlDb *sql.DB) error {
+		res = nil
+		rows, err := sqlDb.QueryContext(ctx, a.getQuery(getLanguageCoverageQuery), scoring.ConfirmedRate, scoring.Weighted)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var item types.LanguageCoverage
+			if err := rows.Scan(&item.Name, &item.Enabled, &item.TranslatedWords, &item.ConfirmedWords); err != nil {
+				return err
+			}
+			res = append(res, item)
+		}
+		return rows.Err()
+	})
+	return res, err
+}
+
+func (a *accessor) AddLanguage(ctx context.Context, language string) error {
+	_, err := a.db.ExecContext(ctx, a.getQuery(addLanguageQuery), language)
+	return err
+}
+
+func (a *accessor) DisableLanguage(ctx context.Context, language string) (bool, error) {
+	res, err := a.db.ExecContext(ctx, a.getQuery(disableLanguageQuery), language)
+	if err != nil {
+		return false, err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+func (a *accessor) ImportTranslations(ctx context.Context, rows []types.ImportTranslationRow, source string, dryRun bool) ([]types.ImportConflict, error) {
+	tx, err := a.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	var conflicts []types.ImportConflict
+	for i, row := range rows {
+		var translationId int
+		err := tx.QueryRowContext(ctx, a.getQuery(getTranslationIdQuery), row.Word, row.Address, row.Language).Scan(&translationId)
+		if err == nil {
+			conflicts = append(conflicts, types.ImportConflict{
+				Row:           i,
+				Word:          row.Word,
+				Language:      row.Language,
+				Address:       row.Address,
+				TranslationId: strconv.Itoa(translationId),
+			})
+			continue
+		}
+		if err != sql.ErrNoRows {
+			return nil, err
+		}
+		if dryRun {
+			continue
+		}
+		res, err := tx.ExecContext(ctx, a.getQuery(importTranslationQuery), row.Word, row.Address, row.Language, row.Name, row.Description, row.UpVotes, source)
+		if err != nil {
+			return nil, err
+		}
+		if affected, err := res.RowsAffected(); err != nil {
+			return nil, err
+		} else if affected == 0 {
+			return nil, errors.Errorf("unknown language '%v'", row.Language)
+		}
+	}
+	if dryRun {
+		return conflicts, nil
+	}
+	return conflicts, tx.Commit()
+}
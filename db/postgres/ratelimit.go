@@ -0,0 +1,36 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"github.com/idena-network/idena-translation/db"
+	log "github.com/inconshreveable/log15"
+	"sync/atomic"
+	"time"
+)
+
+const rateLimitCleanupInterval = time.Minute * 10
+
+// TakeRateLimitToken keeps buckets in the table shared by all instances, the bucket row is locked while the token is
+// taken, so concurrent requests of the key are serialized
+func (a *accessor) TakeRateLimitToken(ctx context.Context, key string, limit db.RateLimit, now time.Time) (time.Duration, error) {
+	a.removeFullRateLimitBuckets(ctx, now)
+	var retryAfterSec float64
+	if err := a.db.QueryRowContext(ctx, a.getQuery(takeRateLimitTokenQuery), key, limit.Rate, limit.Burst, now).
+		Scan(&retryAfterSec); err != nil {
+		return 0, err
+	}
+	return time.Duration(retryAfterSec * float64(time.Second)), nil
+}
+
+// removeFullRateLimitBuckets drops refilled buckets once in rateLimitCleanupInterval since a new bucket is full as well
+func (a *accessor) removeFullRateLimitBuckets(ctx context.Context, now time.Time) {
+	last := atomic.LoadInt64(&a.lastRateLimitCleanup)
+	if now.Sub(time.Unix(last, 0)) < rateLimitCleanupInterval ||
+		!atomic.CompareAndSwapInt64(&a.lastRateLimitCleanup, last, now.Unix()) {
+		return
+	}
+	if _, err := a.db.ExecContext(ctx, a.getQuery(deleteFullRateLimitBucketsQuery), now); err != nil {
+		log.Warn(fmt.Sprintf("Unable to remove full rate limit buckets: %v", err))
+	}
+}
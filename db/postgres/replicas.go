@@ -0,0 +1,154 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/idena-network/idena-translation/config"
+	log "github.com/inconshreveable/log15"
+	"github.com/pkg/errors"
+	"sync/atomic"
+	"time"
+)
+
+// Replay lag of the replica in seconds. It is 0 if everything received from the primary is replayed, otherwise the
+// replay timestamp would keep growing while there are no writes on the primary. The received position stops growing
+// when the replica is disconnected from the primary, so the lag is NULL if there is no streaming WAL receiver. The
+// receiver status is only visible to pg_read_all_stats members, other users see the receiver row with NULL status.
+const replicaLagQuery = `SELECT CASE
+           WHEN NOT pg_is_in_recovery() THEN 0
+           WHEN NOT EXISTS(SELECT FROM pg_stat_wal_receiver WHERE coalesce(status, 'streaming') = 'streaming') THEN NULL
+           WHEN pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+           ELSE coalesce(extract(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0) END`
+
+type replica struct {
+	index     int
+	db        *sql.DB
+	available int32
+}
+
+func (r *replica) isAvailable() bool {
+	return atomic.LoadInt32(&r.available) == 1
+}
+
+func (r *replica) setAvailable(available bool) {
+	var value int32
+	if available {
+		value = 1
+	}
+	if atomic.SwapInt32(&r.available, value) != value {
+		if available {
+			log.Info(fmt.Sprintf("Postgres replica %v is available", r.index))
+		} else {
+			log.Warn(fmt.Sprintf("Postgres replica %v is unavailable, read queries go to primary", r.index))
+		}
+	}
+}
+
+// replicaSet balances read queries between available replicas, replicas are checked periodically and the ones
+// that are down or lag behind the primary are skipped until the next successful check
+type replicaSet struct {
+	replicas      []*replica
+	counter       uint32
+	maxLag        time.Duration
+	checkInterval time.Duration
+}
+
+func newReplicaSet(conf config.PostgresConfig) (*replicaSet, error) {
+	rs := &replicaSet{
+		maxLag:        time.Second * time.Duration(conf.MaxReplicaLagSec),
+		checkInterval: time.Second * time.Duration(conf.ReplicaCheckIntervalSec),
+	}
+	for i, connStr := range conf.ReplicaConnStrs {
+		sqlDb, err := openDb(connStr, conf)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to open replica %v", i)
+		}
+		rs.replicas = append(rs.replicas, &replica{
+			index: i,
+			db:    sqlDb,
+		})
+	}
+	if len(rs.replicas) == 0 {
+		return rs, nil
+	}
+	rs.checkAll()
+	if rs.checkInterval > 0 {
+		go rs.loop()
+	}
+	return rs, nil
+}
+
+// next returns the next available replica or nil if there is no one
+func (rs *replicaSet) next() *replica {
+	n := len(rs.replicas)
+	if n == 0 {
+		return nil
+	}
+	start := int(atomic.AddUint32(&rs.counter, 1))
+	for i := 0; i < n; i++ {
+		r := rs.replicas[(start+i)%n]
+		if r.isAvailable() {
+			return r
+		}
+	}
+	return nil
+}
+
+func (rs *replicaSet) loop() {
+	ticker := time.NewTicker(rs.checkInterval)
+	defer ticker.Stop()
+	for range ticker.C {
+		rs.checkAll()
+	}
+}
+
+func (rs *replicaSet) checkAll() {
+	for _, r := range rs.replicas {
+		err := rs.check(r)
+		if err != nil {
+			log.Debug(fmt.Sprintf("Postgres replica %v check failed: %v", r.index, err))
+		}
+		r.setAvailable(err == nil)
+	}
+}
+
+func (rs *replicaSet) check(r *replica) error {
+	timeout := rs.checkInterval
+	if timeout <= 0 {
+		timeout = time.Second * 5
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	var lagSec sql.NullFloat64
+	if err := r.db.QueryRowContext(ctx, replicaLagQuery).Scan(&lagSec); err != nil {
+		return err
+	}
+	if !lagSec.Valid {
+		return errors.New("replica is not streaming from primary")
+	}
+	if lag := time.Duration(lagSec.Float64 * float64(time.Second)); rs.maxLag > 0 && lag > rs.maxLag {
+		return errors.Errorf("replication lag %v exceeds %v", lag, rs.maxLag)
+	}
+	return nil
+}
+
+func openDb(connStr string, conf config.PostgresConfig) (*sql.DB, error) {
+	sqlDb, err := sql.Open("postgres", connStr)
+	if err != nil {
+		return nil, err
+	}
+	if conf.MaxOpenConns > 0 {
+		sqlDb.SetMaxOpenConns(conf.MaxOpenConns)
+	}
+	if conf.MaxIdleConns > 0 {
+		sqlDb.SetMaxIdleConns(conf.MaxIdleConns)
+	}
+	if conf.ConnMaxLifetimeSec > 0 {
+		sqlDb.SetConnMaxLifetime(time.Second * time.Duration(conf.ConnMaxLifetimeSec))
+	}
+	if conf.ConnMaxIdleTimeSec > 0 {
+		sqlDb.SetConnMaxIdleTime(time.Second * time.Duration(conf.ConnMaxIdleTimeSec))
+	}
+	return sqlDb, nil
+}
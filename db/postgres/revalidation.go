@@ -0,0 +1,194 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"github.com/idena-network/idena-translation/db"
+	"github.com/idena-network/idena-translation/types"
+	"strings"
+)
+
+type revalidationVote struct {
+	address  string
+	up       bool
+	weight   float64
+	excluded bool
+}
+
+type revalidationAuthor struct {
+	address  string
+	excluded bool
+	// revalidated is false for imported translations, their authors are not identities of the network
+	revalidated bool
+}
+
+func (a *accessor) LastRevalidatedEpoch(ctx context.Context) (*uint16, error) {
+	var epoch sql.NullInt32
+	err := a.read(ctx, func(sqlDb *sql.DB) error {
+		return sqlDb.QueryRowContext(ctx, a.getQuery(getLastRevalidatedEpochQuery)).Scan(&epoch)
+	})
+	if err != nil || !epoch.Valid {
+		return nil, err
+	}
+	res := uint16(epoch.Int32)
+	return &res, nil
+}
+
+func (a *accessor) GetParticipants(ctx context.Context) ([]string, error) {
+	var res []string
+	err := a.read(ctx, func(sqlDb *sql.DB) error {
+		res = nil
+		rows, err := sqlDb.QueryContext(ctx, a.getQuery(getParticipantsQuery))
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var address string
+			if err := rows.Scan(&address); err != nil {
+				return err
+			}
+			res = append(res, address)
+		}
+		return rows.Err()
+	})
+	return res, err
+}
+
+// Revalidate locks and updates translations one by one, so votes of other translations are not blocked during the
+// revalidation. Interrupted revalidation is completed by the next call since unchanged votes and translations are skipped.
+func (a *accessor) Revalidate(ctx context.Context, epoch uint16, states map[string]db.ParticipantState, scoring db.Scoring) (db.RevalidationResult, error) {
+	translationIds, err := a.getRevalidationTranslationIds(ctx)
+	if err != nil {
+		return db.RevalidationResult{}, err
+	}
+	var res db.RevalidationResult
+	for _, translationId := range translationIds {
+		translationRes, err := a.revalidateTranslation(ctx, epoch, translationId, states, scoring)
+		if err != nil {
+			return db.RevalidationResult{}, err
+		}
+		res.ChangedVotes += translationRes.ChangedVotes
+		res.ChangedTranslations += translationRes.ChangedTranslations
+		res.ChangedConfirmations += translationRes.ChangedConfirmations
+	}
+	if _, err := a.db.ExecContext(ctx, a.getQuery(insertRevalidationQuery), epoch); err != nil {
+		return db.RevalidationResult{}, err
+	}
+	return res, nil
+}
+
+func (a *accessor) getRevalidationTranslationIds(ctx context.Context) ([]int, error) {
+	rows, err := a.db.QueryContext(ctx, a.getQuery(getRevalidationTranslationIdsQuery))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var res []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		res = append(res, id)
+	}
+	return res, rows.Err()
+}
+
+// revalidateTranslation locks votes before the translation in the same order as vote()
+func (a *accessor) revalidateTranslation(ctx context.Context, epoch uint16, translationId int, states map[string]db.ParticipantState, scoring db.Scoring) (db.RevalidationResult, error) {
+	tx, err := a.db.BeginTx(ctx, nil)
+	if err != nil {
+		return db.RevalidationResult{}, err
+	}
+	defer tx.Rollback()
+	votes, err := a.getRevalidationVotes(ctx, tx, translationId)
+	if err != nil {
+		return db.RevalidationResult{}, err
+	}
+	var author revalidationAuthor
+	err = tx.QueryRowContext(ctx, a.getQuery(getRevalidationAuthorQuery), translationId).
+		Scan(&author.address, &author.excluded, &author.revalidated)
+	if err == sql.ErrNoRows {
+		// The translation is replaced after the list of translations was read
+		return db.RevalidationResult{}, nil
+	}
+	if err != nil {
+		return db.RevalidationResult{}, err
+	}
+	var res db.RevalidationResult
+	var delta db.VoteCounts
+	for _, vote := range votes {
+		state, ok := states[strings.ToLower(vote.address)]
+		if !ok {
+			continue
+		}
+		weight, excluded, changed := state.RevalidateVote(vote.weight, vote.excluded)
+		if !changed {
+			continue
+		}
+		if _, err := tx.ExecContext(ctx, a.getQuery(revalidateVoteQuery), translationId, vote.address, weight, excluded); err != nil {
+			return db.RevalidationResult{}, err
+		}
+		if _, err := tx.ExecContext(ctx, a.getQuery(insertVoteRevalidationQuery), epoch, translationId, vote.address,
+			state.State, vote.up, vote.weight, weight, vote.excluded, excluded); err != nil {
+			return db.RevalidationResult{}, err
+		}
+		voteDelta := db.RevalidatedVoteDelta(vote.up, vote.weight, vote.excluded, weight, excluded)
+		delta.UpVotes += voteDelta.UpVotes
+		delta.DownVotes += voteDelta.DownVotes
+		delta.WeightedUpVotes += voteDelta.WeightedUpVotes
+		delta.WeightedDownVotes += voteDelta.WeightedDownVotes
+		res.ChangedVotes++
+	}
+	state, ok := states[strings.ToLower(author.address)]
+	ok = ok && author.revalidated
+	authorExcluded := author.excluded
+	if ok {
+		authorExcluded = !state.Eligible
+	}
+	if res.ChangedVotes == 0 && authorExcluded == author.excluded {
+		return db.RevalidationResult{}, nil
+	}
+	var prev, cur types.Translation
+	var prevAuthorExcluded bool
+	if err := tx.QueryRowContext(ctx, a.getQuery(revalidateTranslationQuery), translationId, delta.UpVotes,
+		delta.DownVotes, delta.WeightedUpVotes, delta.WeightedDownVotes, authorExcluded).
+		Scan(&prev.UpVotes, &prev.DownVotes, &prev.WeightedUpVotes, &prev.WeightedDownVotes, &prevAuthorExcluded,
+			&cur.UpVotes, &cur.DownVotes, &cur.WeightedUpVotes, &cur.WeightedDownVotes); err != nil {
+		return db.RevalidationResult{}, err
+	}
+	var authorState sql.NullString
+	if ok {
+		authorState = sql.NullString{String: state.State, Valid: true}
+	}
+	prevConfirmed := scoring.IsConfirmed(prev, prevAuthorExcluded)
+	confirmed := scoring.IsConfirmed(cur, authorExcluded)
+	if _, err := tx.ExecContext(ctx, a.getQuery(insertTranslationRevalidationQuery), epoch, translationId,
+		authorState, authorExcluded, prev.UpVotes, prev.DownVotes, prev.WeightedUpVotes, prev.WeightedDownVotes,
+		cur.UpVotes, cur.DownVotes, cur.WeightedUpVotes, cur.WeightedDownVotes, prevConfirmed, confirmed); err != nil {
+		return db.RevalidationResult{}, err
+	}
+	res.ChangedTranslations++
+	if prevConfirmed != confirmed {
+		res.ChangedConfirmations++
+	}
+	return res, tx.Commit()
+}
+
+func (a *accessor) getRevalidationVotes(ctx context.Context, tx *sql.Tx, translationId int) ([]revalidationVote, error) {
+	rows, err := tx.QueryContext(ctx, a.getQuery(getRevalidationVotesQuery), translationId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var res []revalidationVote
+	for rows.Next() {
+		var item revalidationVote
+		if err := rows.Scan(&item.address, &item.up, &item.weight, &item.excluded); err != nil {
+			return nil, err
+		}
+		res = append(res, item)
+	}
+	return res, rows.Err()
+}
@@ -27,29 +27,89 @@ var doc = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/translation": {
-            "post": {
+        "/address/{address}/translations": {
+            "get": {
                 "tags": [
                     "Translation"
                 ],
-                "summary": "Create or update translation",
-                "operationId": "submitTranslation",
+                "summary": "Get translations submitted by the address, the latest first",
+                "operationId": "getAddressTranslations",
                 "parameters": [
                     {
-                        "description": "translation details",
-                        "name": "translation",
-                        "in": "body",
-                        "required": true,
+                        "type": "string",
+                        "description": "address",
+                        "name": "address",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "continuation token to get next translations",
+                        "name": "continuation-token",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/SubmitTranslationRequest"
+                            "$ref": "#/definitions/GetAddressTranslationsResponse"
+                        },
+                        "headers": {
+                            "continuation-token": {
+                                "type": "string",
+                                "description": "continuation token of the next page"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
                         }
                     }
+                }
+            }
+        },
+        "/address/{address}/votes": {
+            "get": {
+                "tags": [
+                    "Translation"
+                ],
+                "summary": "Get votes of the address with voted translations, votes for the latest translations first",
+                "operationId": "getAddressVotes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "address",
+                        "name": "address",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "continuation token to get next votes",
+                        "name": "continuation-token",
+                        "in": "header"
+                    }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/SubmitTranslationResponse"
+                            "$ref": "#/definitions/GetAddressVotesResponse"
+                        },
+                        "headers": {
+                            "continuation-token": {
+                                "type": "string",
+                                "description": "continuation token of the next page"
+                            }
                         }
                     },
                     "400": {
@@ -67,21 +127,21 @@ var doc = `{
                 }
             }
         },
-        "/vote": {
+        "/confirmed-translations": {
             "post": {
                 "tags": [
                     "Translation"
                 ],
-                "summary": "Vote for or against translation",
-                "operationId": "vote",
+                "summary": "Get confirmed translations of many words in one or more languages",
+                "operationId": "getConfirmedTranslationsByWords",
                 "parameters": [
                     {
-                        "description": "vote details",
-                        "name": "vote",
+                        "description": "word ids and languages",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/VoteRequest"
+                            "$ref": "#/definitions/GetConfirmedTranslationsByWordsRequest"
                         }
                     }
                 ],
@@ -89,7 +149,7 @@ var doc = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/VoteResponse"
+                            "$ref": "#/definitions/GetConfirmedTranslationsByWordsResponse"
                         }
                     },
                     "400": {
@@ -107,34 +167,112 @@ var doc = `{
                 }
             }
         },
-        "/word/{word}/language/{language}/confirmed-translation": {
+        "/language/{language}/confirmed-translations": {
             "get": {
+                "produces": [
+                    "application/json",
+                    "text/csv",
+                    "text/x-gettext-translation"
+                ],
                 "tags": [
                     "Translation"
                 ],
-                "summary": "Get confirmed translation",
-                "operationId": "getConfirmedTranslation",
+                "summary": "Export confirmed translations of all words of the language",
+                "operationId": "exportConfirmedTranslations",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "word id",
-                        "name": "word",
+                        "type": "string",
+                        "description": "language",
+                        "name": "language",
                         "in": "path",
                         "required": true
                     },
                     {
+                        "enum": [
+                            "json",
+                            "csv",
+                            "po"
+                        ],
                         "type": "string",
-                        "description": "language",
-                        "name": "language",
-                        "in": "path",
+                        "default": "json",
+                        "description": "export format",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "confirmed translations in requested format",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/languages": {
+            "get": {
+                "tags": [
+                    "Translation"
+                ],
+                "summary": "Get languages with numbers of translated and confirmed words and coverage of the word list",
+                "operationId": "getLanguages",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/GetLanguagesResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "tags": [
+                    "Translation"
+                ],
+                "summary": "Add language or enable disabled one",
+                "operationId": "addLanguage",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "admin api key",
+                        "name": "api-key",
+                        "in": "header",
                         "required": true
+                    },
+                    {
+                        "description": "BCP-47 language tag",
+                        "name": "language",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/AddLanguageRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/GetConfirmedTranslationResponse"
+                            "$ref": "#/definitions/Language"
                         }
                     },
                     "400": {
@@ -143,6 +281,12 @@ var doc = `{
                             "$ref": "#/definitions/ErrorResponse"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -152,19 +296,19 @@ var doc = `{
                 }
             }
         },
-        "/word/{word}/language/{language}/translations": {
-            "get": {
+        "/languages/{language}/disable": {
+            "post": {
                 "tags": [
                     "Translation"
                 ],
-                "summary": "Get translations sorted by rating",
-                "operationId": "getTranslations",
+                "summary": "Disable language, translations of disabled language can be read but not submitted",
+                "operationId": "disableLanguage",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "word id",
-                        "name": "word",
-                        "in": "path",
+                        "type": "string",
+                        "description": "admin api key",
+                        "name": "api-key",
+                        "in": "header",
                         "required": true
                     },
                     {
@@ -173,25 +317,13 @@ var doc = `{
                         "name": "language",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "continuation token to get next translations",
-                        "name": "continuation-token",
-                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/GetTranslationsResponse"
-                        },
-                        "headers": {
-                            "continuation-token": {
-                                "type": "string",
-                                "description": "continuation token"
-                            }
+                            "$ref": "#/definitions/Language"
                         }
                     },
                     "400": {
@@ -200,6 +332,12 @@ var doc = `{
                             "$ref": "#/definitions/ErrorResponse"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -208,52 +346,1152 @@ var doc = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "ErrorResponse": {
-            "type": "object",
-            "properties": {
-                "error": {
-                    "type": "string"
-                }
-            }
         },
-        "GetConfirmedTranslationResponse": {
-            "type": "object",
-            "properties": {
-                "translation": {
-                    "type": "object",
-                    "$ref": "#/definitions/Translation"
+        "/moderation/translations": {
+            "get": {
+                "tags": [
+                    "Translation"
+                ],
+                "summary": "Get translations hidden by reports pending review",
+                "operationId": "getModerationQueue",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "moderator or admin api key",
+                        "name": "api-key",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/GetModerationQueueResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
                 }
             }
         },
-        "GetTranslationsResponse": {
-            "type": "object",
-            "properties": {
-                "translations": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/Translation"
+        "/node/status": {
+            "get": {
+                "tags": [
+                    "Translation"
+                ],
+                "summary": "Get health and circuit breaker state of nodes",
+                "operationId": "getNodeStatus",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "admin api key",
+                        "name": "api-key",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/GetNodeStatusResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "SubmitTranslationRequest": {
-            "type": "object",
-            "properties": {
-                "description": {
-                    "type": "string",
-                    "maxLength": 150
-                },
-                "language": {
-                    "type": "string",
-                    "example": "en"
-                },
-                "name": {
-                    "type": "string",
-                    "maxLength": 30
-                },
+        "/report": {
+            "post": {
+                "tags": [
+                    "Translation"
+                ],
+                "summary": "Report abusive translation, the translation is hidden pending review after enough reports",
+                "operationId": "report",
+                "parameters": [
+                    {
+                        "description": "report details",
+                        "name": "report",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/ReportRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ReportResponse"
+                        },
+                        "headers": {
+                            "Retry-After": {
+                                "type": "integer",
+                                "description": "seconds after which the rate limited request can be repeated"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/translation": {
+            "post": {
+                "tags": [
+                    "Translation"
+                ],
+                "summary": "Create or update translation",
+                "operationId": "submitTranslation",
+                "parameters": [
+                    {
+                        "description": "translation details",
+                        "name": "translation",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/SubmitTranslationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/SubmitTranslationResponse"
+                        },
+                        "headers": {
+                            "Retry-After": {
+                                "type": "integer",
+                                "description": "seconds after which the rate limited request can be repeated"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/translation/{id}/history": {
+            "get": {
+                "tags": [
+                    "Translation"
+                ],
+                "summary": "Get earlier versions of translation replaced by resubmissions",
+                "operationId": "getTranslationHistory",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "translation id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/GetTranslationHistoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/translation/{id}/remove": {
+            "post": {
+                "tags": [
+                    "Translation"
+                ],
+                "summary": "Remove reported translation permanently and resolve its reports",
+                "operationId": "removeTranslation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "moderator or admin api key",
+                        "name": "api-key",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "translation id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ModeratedTranslation"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/translation/{id}/restore": {
+            "post": {
+                "tags": [
+                    "Translation"
+                ],
+                "summary": "Make reported translation visible and resolve its reports",
+                "operationId": "restoreTranslation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "moderator or admin api key",
+                        "name": "api-key",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "translation id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ModeratedTranslation"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/translations/import": {
+            "post": {
+                "consumes": [
+                    "application/json",
+                    "text/csv"
+                ],
+                "tags": [
+                    "Translation"
+                ],
+                "summary": "Import translations from csv or json file, rows conflicting with existing translations are skipped",
+                "operationId": "importTranslations",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "admin api key",
+                        "name": "api-key",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "provenance marker stored with imported translations",
+                        "name": "source",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "csv"
+                        ],
+                        "type": "string",
+                        "default": "json",
+                        "description": "file format",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "language of rows without language",
+                        "name": "language",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "only report conflicts and errors without importing",
+                        "name": "dry-run",
+                        "in": "query"
+                    },
+                    {
+                        "description": "json array of rows or csv with header: word,name,description[,language][,address][,upVotes]",
+                        "name": "file",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ImportTranslationsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/vote": {
+            "post": {
+                "tags": [
+                    "Translation"
+                ],
+                "summary": "Vote for or against translation",
+                "operationId": "vote",
+                "parameters": [
+                    {
+                        "description": "vote details",
+                        "name": "vote",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/VoteRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/VoteResponse"
+                        },
+                        "headers": {
+                            "Retry-After": {
+                                "type": "integer",
+                                "description": "seconds after which the rate limited request can be repeated"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/vote/retract": {
+            "post": {
+                "tags": [
+                    "Translation"
+                ],
+                "summary": "Retract vote for or against translation",
+                "operationId": "retractVote",
+                "parameters": [
+                    {
+                        "description": "retraction details",
+                        "name": "retraction",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/RetractVoteRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/RetractVoteResponse"
+                        },
+                        "headers": {
+                            "Retry-After": {
+                                "type": "integer",
+                                "description": "seconds after which the rate limited request can be repeated"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/word/{word}/language/{language}/confirmed-translation": {
+            "get": {
+                "tags": [
+                    "Translation"
+                ],
+                "summary": "Get confirmed translation",
+                "operationId": "getConfirmedTranslation",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "word id",
+                        "name": "word",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "language",
+                        "name": "language",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "address to return its vote and translation",
+                        "name": "address",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/GetConfirmedTranslationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/word/{word}/language/{language}/translations": {
+            "get": {
+                "tags": [
+                    "Translation"
+                ],
+                "summary": "Get translations sorted by rating",
+                "operationId": "getTranslations",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "word id",
+                        "name": "word",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "language",
+                        "name": "language",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "continuation token to get next or previous translations",
+                        "name": "continuation-token",
+                        "in": "header"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "return total number of translations",
+                        "name": "total",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "address to return its votes and translations",
+                        "name": "address",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/GetTranslationsResponse"
+                        },
+                        "headers": {
+                            "continuation-token": {
+                                "type": "string",
+                                "description": "continuation token of the next page"
+                            },
+                            "prev-continuation-token": {
+                                "type": "string",
+                                "description": "continuation token of the previous page"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "AddLanguageRequest": {
+            "type": "object",
+            "properties": {
+                "language": {
+                    "type": "string",
+                    "maxLength": 35,
+                    "example": "pt-BR"
+                }
+            }
+        },
+        "AddressVote": {
+            "type": "object",
+            "properties": {
+                "excluded": {
+                    "description": "Excluded is true if the vote is not counted since the voter was not eligible at the last revalidation",
+                    "type": "boolean"
+                },
+                "timestamp": {
+                    "type": "string",
+                    "example": "2020-01-01T00:00:00Z"
+                },
+                "translation": {
+                    "type": "object",
+                    "$ref": "#/definitions/ContributedTranslation"
+                },
+                "up": {
+                    "type": "boolean"
+                },
+                "weight": {
+                    "type": "number"
+                }
+            }
+        },
+        "ContributedTranslation": {
+            "type": "object",
+            "properties": {
+                "confirmed": {
+                    "type": "boolean"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "downVotes": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "language": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "status": {
+                    "description": "Status is the moderation status, the name and description of hidden and removed translations are empty",
+                    "type": "string",
+                    "enum": [
+                        "visible",
+                        "hidden",
+                        "removed"
+                    ]
+                },
+                "timestamp": {
+                    "type": "string",
+                    "example": "2020-01-01T00:00:00Z"
+                },
+                "upVotes": {
+                    "type": "integer"
+                },
+                "weightedDownVotes": {
+                    "type": "number"
+                },
+                "weightedUpVotes": {
+                    "type": "number"
+                },
+                "word": {
+                    "type": "integer"
+                }
+            }
+        },
+        "ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                }
+            }
+        },
+        "GetAddressTranslationsResponse": {
+            "type": "object",
+            "properties": {
+                "translations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ContributedTranslation"
+                    }
+                }
+            }
+        },
+        "GetAddressVotesResponse": {
+            "type": "object",
+            "properties": {
+                "votes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/AddressVote"
+                    }
+                }
+            }
+        },
+        "GetConfirmedTranslationResponse": {
+            "type": "object",
+            "properties": {
+                "translation": {
+                    "type": "object",
+                    "$ref": "#/definitions/Translation"
+                }
+            }
+        },
+        "GetConfirmedTranslationsByWordsRequest": {
+            "type": "object",
+            "properties": {
+                "languages": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "words": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "GetConfirmedTranslationsByWordsResponse": {
+            "type": "object",
+            "properties": {
+                "translations": {
+                    "description": "Translations are confirmed translations by language and word id, words without confirmed translation are null",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "object",
+                        "additionalProperties": {
+                            "$ref": "#/definitions/Translation"
+                        }
+                    }
+                }
+            }
+        },
+        "GetLanguagesResponse": {
+            "type": "object",
+            "properties": {
+                "languages": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/LanguageCoverage"
+                    }
+                }
+            }
+        },
+        "GetModerationQueueResponse": {
+            "type": "object",
+            "properties": {
+                "translations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ModeratedTranslation"
+                    }
+                }
+            }
+        },
+        "GetNodeStatusResponse": {
+            "type": "object",
+            "properties": {
+                "identityCache": {
+                    "description": "IdentityCache is missing if the identity cache is disabled",
+                    "type": "object",
+                    "$ref": "#/definitions/IdentityCacheStats"
+                },
+                "nodes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/NodeStatus"
+                    }
+                }
+            }
+        },
+        "GetTranslationHistoryResponse": {
+            "type": "object",
+            "properties": {
+                "revisions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/TranslationRevision"
+                    }
+                }
+            }
+        },
+        "GetTranslationsResponse": {
+            "type": "object",
+            "properties": {
+                "total": {
+                    "description": "Total is the number of translations of the word, it is returned on request only",
+                    "type": "integer"
+                },
+                "translations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/Translation"
+                    }
+                }
+            }
+        },
+        "IdentityCacheStats": {
+            "type": "object",
+            "properties": {
+                "hits": {
+                    "type": "integer"
+                },
+                "misses": {
+                    "type": "integer"
+                }
+            }
+        },
+        "ImportConflict": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "language": {
+                    "type": "string"
+                },
+                "row": {
+                    "type": "integer"
+                },
+                "translationId": {
+                    "type": "string"
+                },
+                "word": {
+                    "type": "integer"
+                }
+            }
+        },
+        "ImportRowError": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "row": {
+                    "type": "integer"
+                }
+            }
+        },
+        "ImportTranslationsResponse": {
+            "type": "object",
+            "properties": {
+                "conflicts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ImportConflict"
+                    }
+                },
+                "dryRun": {
+                    "type": "boolean"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ImportRowError"
+                    }
+                },
+                "imported": {
+                    "type": "integer"
+                }
+            }
+        },
+        "Language": {
+            "type": "object",
+            "properties": {
+                "enabled": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "pt-BR"
+                }
+            }
+        },
+        "LanguageCoverage": {
+            "type": "object",
+            "properties": {
+                "confirmedWords": {
+                    "description": "ConfirmedWords is the number of words with a confirmed translation",
+                    "type": "integer"
+                },
+                "coverage": {
+                    "description": "Coverage is the percentage of the word list with a confirmed translation, it is missing if the word list is\nnot loaded",
+                    "type": "number",
+                    "example": 12.5
+                },
+                "enabled": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "pt-BR"
+                },
+                "translatedWords": {
+                    "description": "TranslatedWords is the number of words with at least one translation",
+                    "type": "integer"
+                }
+            }
+        },
+        "ModeratedTranslation": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "language": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "reports": {
+                    "description": "Reports are numbers of unresolved reports by reason",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ReportCount"
+                    }
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "visible",
+                        "hidden",
+                        "removed"
+                    ]
+                },
+                "word": {
+                    "type": "integer"
+                }
+            }
+        },
+        "NodeStatus": {
+            "type": "object",
+            "properties": {
+                "breaker": {
+                    "type": "string",
+                    "enum": [
+                        "closed",
+                        "open",
+                        "half-open"
+                    ]
+                },
+                "consecutiveFailures": {
+                    "type": "integer"
+                },
+                "healthy": {
+                    "type": "boolean"
+                },
+                "lastCheck": {
+                    "type": "string",
+                    "example": "2020-01-01T00:00:00Z"
+                },
+                "lastEpoch": {
+                    "type": "integer"
+                },
+                "lastError": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "ReportCount": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "reason": {
+                    "type": "string"
+                }
+            }
+        },
+        "ReportRequest": {
+            "type": "object",
+            "properties": {
+                "reason": {
+                    "type": "string",
+                    "enum": [
+                        "spam",
+                        "offensive",
+                        "incorrect",
+                        "other"
+                    ]
+                },
+                "signature": {
+                    "type": "string"
+                },
+                "timestamp": {
+                    "type": "string",
+                    "example": "2020-01-01T00:00:00Z"
+                },
+                "translationId": {
+                    "type": "string"
+                },
+                "version": {
+                    "description": "Version is the format of the signed value, reports are only accepted in version 2",
+                    "type": "integer",
+                    "enum": [
+                        2
+                    ]
+                }
+            }
+        },
+        "ReportResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "hidden": {
+                    "description": "Hidden is true if the translation is hidden pending review",
+                    "type": "boolean"
+                },
+                "resCode": {
+                    "type": "integer",
+                    "enum": [
+                        0,
+                        1,
+                        6,
+                        7,
+                        8,
+                        9,
+                        10
+                    ]
+                },
+                "retryAfter": {
+                    "description": "RetryAfter is the number of seconds after which the rate limited request can be repeated",
+                    "type": "integer"
+                }
+            }
+        },
+        "RetractVoteRequest": {
+            "type": "object",
+            "properties": {
+                "signature": {
+                    "type": "string"
+                },
+                "timestamp": {
+                    "type": "string",
+                    "example": "2020-01-01T00:00:00Z"
+                },
+                "translationId": {
+                    "type": "string"
+                },
+                "version": {
+                    "description": "Version is the format of the signed value, retractions are only accepted in version 2",
+                    "type": "integer",
+                    "enum": [
+                        2
+                    ]
+                }
+            }
+        },
+        "RetractVoteResponse": {
+            "type": "object",
+            "properties": {
+                "downVotes": {
+                    "type": "integer"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "resCode": {
+                    "type": "integer",
+                    "enum": [
+                        0,
+                        4,
+                        6,
+                        7,
+                        8,
+                        11
+                    ]
+                },
+                "retryAfter": {
+                    "description": "RetryAfter is the number of seconds after which the rate limited request can be repeated",
+                    "type": "integer"
+                },
+                "upVotes": {
+                    "type": "integer"
+                },
+                "weightedDownVotes": {
+                    "type": "number"
+                },
+                "weightedUpVotes": {
+                    "type": "number"
+                }
+            }
+        },
+        "SubmitTranslationRequest": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 150,
+                    "minLength": 0
+                },
+                "language": {
+                    "type": "string",
+                    "example": "en"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 30,
+                    "minLength": 1
+                },
                 "signature": {
                     "type": "string"
                 },
@@ -261,9 +1499,18 @@ var doc = `{
                     "type": "string",
                     "example": "2020-01-01T00:00:00Z"
                 },
+                "version": {
+                    "description": "Version is the format of the signed value, requests without version are signed in the legacy format",
+                    "type": "integer",
+                    "enum": [
+                        1,
+                        2
+                    ]
+                },
                 "word": {
                     "type": "integer",
-                    "maximum": 4615
+                    "maximum": 4615,
+                    "minimum": 0
                 }
             }
         },
@@ -279,9 +1526,16 @@ var doc = `{
                         0,
                         1,
                         2,
-                        4
+                        4,
+                        6,
+                        7,
+                        8
                     ]
                 },
+                "retryAfter": {
+                    "description": "RetryAfter is the number of seconds after which the rate limited request can be repeated",
+                    "type": "integer"
+                },
                 "translationId": {
                     "type": "string"
                 }
@@ -293,6 +1547,45 @@ var doc = `{
                 "confirmed": {
                     "type": "boolean"
                 },
+                "description": {
+                    "type": "string"
+                },
+                "downVotes": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "isMine": {
+                    "description": "IsMine is true if the translation is submitted by the address passed with the request",
+                    "type": "boolean"
+                },
+                "myVote": {
+                    "description": "MyVote is the vote of the address passed with the request, it is only set if the address is passed",
+                    "type": "string",
+                    "enum": [
+                        "up",
+                        "down",
+                        "none"
+                    ]
+                },
+                "name": {
+                    "type": "string"
+                },
+                "upVotes": {
+                    "type": "integer"
+                },
+                "weightedDownVotes": {
+                    "type": "number"
+                },
+                "weightedUpVotes": {
+                    "type": "number"
+                }
+            }
+        },
+        "TranslationRevision": {
+            "type": "object",
+            "properties": {
                 "description": {
                     "type": "string"
                 },
@@ -305,6 +1598,14 @@ var doc = `{
                 "name": {
                     "type": "string"
                 },
+                "replacedAt": {
+                    "type": "string",
+                    "example": "2020-01-01T00:00:00Z"
+                },
+                "timestamp": {
+                    "type": "string",
+                    "example": "2020-01-01T00:00:00Z"
+                },
                 "upVotes": {
                     "type": "integer"
                 }
@@ -325,6 +1626,14 @@ var doc = `{
                 },
                 "up": {
                     "type": "boolean"
+                },
+                "version": {
+                    "description": "Version is the format of the signed value, requests without version are signed in the legacy format",
+                    "type": "integer",
+                    "enum": [
+                        1,
+                        2
+                    ]
                 }
             }
         },
@@ -343,11 +1652,24 @@ var doc = `{
                         0,
                         3,
                         4,
-                        5
+                        5,
+                        6,
+                        7,
+                        8
                     ]
                 },
+                "retryAfter": {
+                    "description": "RetryAfter is the number of seconds after which the rate limited request can be repeated",
+                    "type": "integer"
+                },
                 "upVotes": {
                     "type": "integer"
+                },
+                "weightedDownVotes": {
+                    "type": "number"
+                },
+                "weightedUpVotes": {
+                    "type": "number"
                 }
             }
         }
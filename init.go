@@ -1,16 +1,24 @@
 package main
 
 import (
+	"crypto/rand"
+	"fmt"
 	"github.com/idena-network/idena-translation/config"
 	"github.com/idena-network/idena-translation/core"
+	"github.com/idena-network/idena-translation/core/continuation"
+	"github.com/idena-network/idena-translation/core/ratelimit"
+	"github.com/idena-network/idena-translation/core/replay"
+	"github.com/idena-network/idena-translation/core/signing"
 	"github.com/idena-network/idena-translation/core/words_mapper"
 	"github.com/idena-network/idena-translation/db"
+	"github.com/idena-network/idena-translation/db/memory"
 	"github.com/idena-network/idena-translation/db/postgres"
 	"github.com/idena-network/idena-translation/node"
 	"github.com/idena-network/idena-translation/server"
 	log "github.com/inconshreveable/log15"
 	"os"
 	"runtime"
+	"time"
 )
 
 func initLogger(verbosity int) {
@@ -27,23 +35,135 @@ func initLogger(verbosity int) {
 func startServer(appConfig *config.Config) {
 	initLogger(appConfig.Verbosity)
 	log.Info("App is starting...")
-	server.NewServer(appConfig.Server.Port, initAuth(appConfig)).Start(appConfig.Swagger)
+	dbAccessor := initDbAccessor(appConfig)
+	remoteNodeClient := initRemoteNodeClient(appConfig)
+	if appConfig.Revalidation.Enabled {
+		// Identities are requested without the cache since states are changed at the epoch change
+		core.NewRevalidator(dbAccessor, remoteNodeClient, initScoring(appConfig), appConfig.VoteWeights,
+			appConfig.Revalidation.Concurrency).Start(
+			time.Second*time.Duration(appConfig.Revalidation.CheckIntervalSec),
+			time.Second*time.Duration(appConfig.Revalidation.TimeoutSec),
+		)
+	}
+	server.NewServer(appConfig.Server, newEngine(appConfig, dbAccessor, remoteNodeClient)).Start(appConfig.Swagger)
 }
 
-func initAuth(appConfig *config.Config) core.Engine {
+func newEngine(appConfig *config.Config, dbAccessor db.Accessor, remoteNodeClient node.Client) core.Engine {
 	return core.NewEngine(
-		initDbAccessor(appConfig),
-		initNodeClient(appConfig),
+		dbAccessor,
+		initNodeClient(appConfig, remoteNodeClient),
 		appConfig.ItemsLimit,
-		appConfig.ConfirmedRate,
+		initScoring(appConfig),
+		appConfig.VoteWeights,
 		words_mapper.NewWordsMapper(appConfig.WordsUrl),
+		initContinuationTokenCodec(appConfig),
+		replay.NewGuard(time.Second*time.Duration(appConfig.SignedRequestWindowSec)),
+		signing.Format{
+			Network:      appConfig.Signing.Network,
+			AcceptLegacy: appConfig.Signing.AcceptLegacy,
+		},
+		initRateLimiter(appConfig, dbAccessor),
+		appConfig.Moderation.HideThreshold,
 	)
 }
 
+func initRateLimiter(appConfig *config.Config, dbAccessor db.Accessor) *ratelimit.Limiter {
+	var store db.RateLimitStore
+	switch appConfig.RateLimits.Store {
+	case config.MemoryRateLimitStore:
+		store = memory.NewRateLimitStore()
+	case config.DbRateLimitStore:
+		store = dbAccessor
+	default:
+		panic(fmt.Sprintf("unknown rate limit store '%v'", appConfig.RateLimits.Store))
+	}
+	routes := make(map[string]ratelimit.RouteLimits, len(appConfig.RateLimits.Routes))
+	for route, limits := range appConfig.RateLimits.Routes {
+		routes[route] = ratelimit.RouteLimits{
+			Address: db.RateLimit{Rate: limits.Address.PerMinute / 60, Burst: limits.Address.Burst},
+			Ip:      db.RateLimit{Rate: limits.Ip.PerMinute / 60, Burst: limits.Ip.Burst},
+		}
+	}
+	return ratelimit.NewLimiter(store, routes)
+}
+
+func initScoring(appConfig *config.Config) db.Scoring {
+	return db.Scoring{
+		ConfirmedRate: appConfig.ConfirmedRate,
+		Weighted:      appConfig.WeightedScore,
+	}
+}
+
+func initContinuationTokenCodec(appConfig *config.Config) *continuation.Codec {
+	secret := []byte(appConfig.ContinuationToken.Secret)
+	if len(secret) == 0 {
+		log.Warn("Continuation token secret is not set, tokens will be invalid after restart")
+		secret = make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			panic(err)
+		}
+	}
+	return continuation.NewCodec(secret, time.Second*time.Duration(appConfig.ContinuationToken.TtlSec))
+}
+
 func initDbAccessor(appConfig *config.Config) db.Accessor {
-	return postgres.NewAccessor(appConfig.Postgres.ConnStr, appConfig.Postgres.ScriptsDir)
+	switch appConfig.DbType {
+	case config.MemoryDbType:
+		log.Warn("In-memory db is used, data will be lost on restart")
+		return memory.NewAccessor()
+	case config.PostgresDbType:
+		return postgres.NewAccessor(appConfig.Postgres)
+	default:
+		panic(fmt.Sprintf("unknown db type '%v'", appConfig.DbType))
+	}
+}
+
+// initRemoteNodeClient creates the client of the nodes from the config, the client is shared by the engine and
+// the revalidator so that nodes are checked once
+func initRemoteNodeClient(appConfig *config.Config) node.Client {
+	var urls []string
+	if len(appConfig.Api.Url) > 0 {
+		urls = append(urls, appConfig.Api.Url)
+	}
+	for _, url := range appConfig.Api.Urls {
+		if url != appConfig.Api.Url {
+			urls = append(urls, url)
+		}
+	}
+	clientConfig := node.ClientConfig{
+		Timeout:             time.Second * time.Duration(appConfig.Api.TimeoutSec),
+		Retries:             appConfig.Api.Retries,
+		RetryBackoff:        time.Millisecond * time.Duration(appConfig.Api.RetryBackoffMs),
+		MaxRetryBackoff:     time.Millisecond * time.Duration(appConfig.Api.MaxRetryBackoffMs),
+		BreakerFailures:     appConfig.Api.BreakerFailures,
+		BreakerCooldown:     time.Second * time.Duration(appConfig.Api.BreakerCooldownSec),
+		HealthCheckInterval: time.Second * time.Duration(appConfig.Api.HealthCheckIntervalSec),
+	}
+	switch appConfig.Api.Backend {
+	case config.RestApiBackend:
+		return node.NewClient(urls, clientConfig)
+	case config.RpcApiBackend:
+		return node.NewRpcClient(urls, appConfig.Api.ApiKey, clientConfig)
+	default:
+		panic(fmt.Sprintf("unknown api backend '%v'", appConfig.Api.Backend))
+	}
 }
 
-func initNodeClient(appConfig *config.Config) node.Client {
-	return node.NewClient(appConfig.Api.Url)
+// initNodeClient decorates the remote client with local signature recovery and the identity cache
+func initNodeClient(appConfig *config.Config, client node.Client) node.Client {
+	switch appConfig.Api.SignatureRecovery {
+	case config.LocalSignatureRecovery:
+		client = node.NewLocalSignatureClient(client)
+	case config.NodeSignatureRecovery:
+	default:
+		panic(fmt.Sprintf("unknown signature recovery '%v'", appConfig.Api.SignatureRecovery))
+	}
+	if appConfig.Api.IdentityCacheTtlSec <= 0 {
+		return client
+	}
+	return node.NewCachingClient(
+		client,
+		time.Second*time.Duration(appConfig.Api.IdentityCacheTtlSec),
+		time.Second*time.Duration(appConfig.Api.EpochCheckIntervalSec),
+	)
 }
@@ -1,7 +1,10 @@
 package main
 
 import (
+	"fmt"
 	"github.com/idena-network/idena-translation/config"
+	"github.com/idena-network/idena-translation/core/export"
+	"github.com/idena-network/idena-translation/db/postgres"
 	"github.com/idena-network/idena-translation/types"
 	"gopkg.in/urfave/cli.v1"
 	"os"
@@ -25,5 +28,99 @@ func main() {
 		startServer(appConfig)
 		return nil
 	}
+	app.Commands = []cli.Command{
+		{
+			Name:  "migrate",
+			Usage: "Apply or revert postgres schema migrations",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  "version",
+					Usage: "Target schema version, -1 to apply all migrations",
+					Value: postgres.LatestVersion,
+				},
+				cli.BoolFlag{
+					Name:  "status",
+					Usage: "Print current schema version without migrating",
+				},
+			},
+			Action: func(context *cli.Context) error {
+				appConfig := config.LoadConfig(context.GlobalString("config"))
+				initLogger(appConfig.Verbosity)
+				if !context.Bool("status") {
+					if err := postgres.Migrate(appConfig.Postgres.ConnStr, appConfig.Postgres.ScriptsDir, context.Int("version")); err != nil {
+						return cli.NewExitError(err.Error(), 1)
+					}
+				}
+				version, err := postgres.SchemaVersion(appConfig.Postgres.ConnStr)
+				if err != nil {
+					return cli.NewExitError(err.Error(), 1)
+				}
+				fmt.Printf("Schema version: %d\n", version)
+				return nil
+			},
+		},
+		{
+			Name:  "export",
+			Usage: "Export confirmed translations of the language",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "language",
+					Usage: "Language to export",
+				},
+				cli.StringFlag{
+					Name:  "format",
+					Usage: "Export format: json, csv or po",
+					Value: export.JsonFormat,
+				},
+				cli.StringFlag{
+					Name:  "output",
+					Usage: "Output file, stdout if empty",
+				},
+			},
+			Action: func(context *cli.Context) error {
+				appConfig := config.LoadConfig(context.GlobalString("config"))
+				initLogger(appConfig.Verbosity)
+				if err := exportConfirmedTranslations(appConfig, context.String("language"), context.String("format"), context.String("output")); err != nil {
+					return cli.NewExitError(err.Error(), 1)
+				}
+				return nil
+			},
+		},
+		{
+			Name:  "import",
+			Usage: "Import translations from csv or json file",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "file",
+					Usage: "File to import",
+				},
+				cli.StringFlag{
+					Name:  "format",
+					Usage: "File format: json or csv",
+					Value: export.JsonFormat,
+				},
+				cli.StringFlag{
+					Name:  "source",
+					Usage: "Provenance marker stored with imported translations",
+				},
+				cli.StringFlag{
+					Name:  "language",
+					Usage: "Language of rows without language",
+				},
+				cli.BoolFlag{
+					Name:  "dry-run",
+					Usage: "Only report conflicts and errors without importing",
+				},
+			},
+			Action: func(context *cli.Context) error {
+				appConfig := config.LoadConfig(context.GlobalString("config"))
+				initLogger(appConfig.Verbosity)
+				if err := importTranslations(appConfig, context.String("file"), context.String("format"), context.String("source"), context.String("language"), context.Bool("dry-run")); err != nil {
+					return cli.NewExitError(err.Error(), 1)
+				}
+				return nil
+			},
+		},
+	}
 	app.Run(os.Args)
 }
@@ -0,0 +1,138 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	log "github.com/inconshreveable/log15"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// CachingClient keeps identities returned by the underlying client for ttl. Identity states change at the
+// validation, so the cache is dropped when the epoch change is detected.
+type CachingClient struct {
+	Client
+	ttl        time.Duration
+	mutex      sync.RWMutex
+	identities map[string]cachedIdentity
+	epoch      uint16
+	epochKnown bool
+	hits       uint64
+	misses     uint64
+}
+
+type cachedIdentity struct {
+	identity  Identity
+	expiresAt time.Time
+}
+
+type CacheStats struct {
+	Hits   uint64
+	Misses uint64
+}
+
+// CacheStatsSource is the client with the identity cache, the engine reports its stats in the node status
+type CacheStatsSource interface {
+	Stats() CacheStats
+}
+
+// NewCachingClient creates the decorator of the client, the epoch is checked every epochCheckInterval if it is positive
+func NewCachingClient(client Client, ttl time.Duration, epochCheckInterval time.Duration) *CachingClient {
+	c := &CachingClient{
+		Client:     client,
+		ttl:        ttl,
+		identities: make(map[string]cachedIdentity),
+	}
+	if epochCheckInterval > 0 {
+		go c.loop(epochCheckInterval)
+	}
+	return c
+}
+
+func (c *CachingClient) GetIdentity(ctx context.Context, address string) (Identity, error) {
+	key := strings.ToLower(address)
+	c.mutex.RLock()
+	cached, ok := c.identities[key]
+	c.mutex.RUnlock()
+	if ok && time.Now().Before(cached.expiresAt) {
+		atomic.AddUint64(&c.hits, 1)
+		return cached.identity, nil
+	}
+	atomic.AddUint64(&c.misses, 1)
+	c.mutex.RLock()
+	epoch := c.epoch
+	c.mutex.RUnlock()
+	identity, err := c.Client.GetIdentity(ctx, address)
+	if err != nil {
+		return Identity{}, err
+	}
+	c.mutex.Lock()
+	// The state could be requested before the epoch change and is not cached in this case
+	if c.epoch == epoch {
+		c.identities[key] = cachedIdentity{
+			identity:  identity,
+			expiresAt: time.Now().Add(c.ttl),
+		}
+	}
+	c.mutex.Unlock()
+	return identity, nil
+}
+
+// Stats returns numbers of cache hits and misses since the start
+func (c *CachingClient) Stats() CacheStats {
+	return CacheStats{
+		Hits:   atomic.LoadUint64(&c.hits),
+		Misses: atomic.LoadUint64(&c.misses),
+	}
+}
+
+// Invalidate drops all the cached identities
+func (c *CachingClient) Invalidate() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.identities = make(map[string]cachedIdentity)
+}
+
+func (c *CachingClient) loop(epochCheckInterval time.Duration) {
+	ticker := time.NewTicker(epochCheckInterval)
+	defer ticker.Stop()
+	for range ticker.C {
+		c.checkEpoch(epochCheckInterval)
+		c.removeExpired()
+	}
+}
+
+func (c *CachingClient) checkEpoch(timeout time.Duration) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	epoch, err := c.Client.LastEpoch(ctx)
+	if err != nil {
+		log.Warn(fmt.Sprintf("Unable to get last epoch: %v", err))
+		return
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.epochKnown && c.epoch == epoch {
+		return
+	}
+	if c.epochKnown {
+		stats := c.Stats()
+		log.Info(fmt.Sprintf("Epoch %v started, identity cache is dropped, hits: %v, misses: %v", epoch, stats.Hits, stats.Misses))
+	}
+	c.epoch = epoch
+	c.epochKnown = true
+	c.identities = make(map[string]cachedIdentity)
+}
+
+func (c *CachingClient) removeExpired() {
+	now := time.Now()
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for key, cached := range c.identities {
+		if !now.Before(cached.expiresAt) {
+			delete(c.identities, key)
+		}
+	}
+}
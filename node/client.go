@@ -1,6 +1,7 @@
 package node
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/idena-network/idena-translation/types"
@@ -12,8 +13,19 @@ import (
 )
 
 type Client interface {
-	GetSignatureAddress(value, signature string) (string, error)
-	IsIdentity(address string) (bool, error)
+	GetSignatureAddress(ctx context.Context, value, signature string) (string, error)
+	// GetIdentity returns the identity with the empty state if the node has no data about the address
+	GetIdentity(ctx context.Context, address string) (Identity, error)
+	LastEpoch(ctx context.Context) (uint16, error)
+	// Endpoints returns the state of node endpoints the client sends requests to
+	Endpoints() []EndpointStatus
+}
+
+// nodeApi is the api of a single node
+type nodeApi interface {
+	GetSignatureAddress(ctx context.Context, value, signature string) (string, error)
+	GetIdentity(ctx context.Context, address string) (Identity, error)
+	LastEpoch(ctx context.Context) (uint16, error)
 }
 
 type Response struct {
@@ -27,23 +39,48 @@ type RespError struct {
 
 type Identity struct {
 	State string `json:"state"`
+	Age   uint16 `json:"age"`
+}
+
+// IsValidated returns true if the identity passed the validation and is allowed to submit translations and vote
+func (i Identity) IsValidated() bool {
+	return i.State == "Newbie" || i.State == "Verified" || i.State == "Human"
+}
+
+type Epoch struct {
+	Epoch uint16 `json:"epoch"`
 }
 
-func NewClient(apiUrl string) Client {
-	return &clientImpl{
+// restNode calls the rest api of the node, errors of the node availability are returned as *unavailableError
+type restNode struct {
+	apiUrl     string
+	httpClient *http.Client
+}
+
+func newRestNode(apiUrl string, timeout time.Duration) *restNode {
+	return &restNode{
 		apiUrl: apiUrl,
+		httpClient: &http.Client{
+			Timeout: timeout,
+		},
 	}
 }
 
-type clientImpl struct {
-	apiUrl string
+// unavailableError means the node has not answered the request, so the request can be retried on another node
+type unavailableError struct {
+	url string
+	err error
+}
+
+func (e *unavailableError) Error() string {
+	return fmt.Sprintf("node %v is unavailable: %v", e.url, e.err)
 }
 
-func (c *clientImpl) GetSignatureAddress(value, signature string) (string, error) {
+func (c *restNode) GetSignatureAddress(ctx context.Context, value, signature string) (string, error) {
 	urlValues := url.Values{}
 	urlValues.Add("value", value)
 	urlValues.Add("signature", signature)
-	responseBytes, err := sendRequest(fmt.Sprintf("%v/api/SignatureAddress?%v", c.apiUrl, urlValues.Encode()))
+	responseBytes, err := c.sendRequest(ctx, fmt.Sprintf("%v/api/SignatureAddress?%v", c.apiUrl, urlValues.Encode()))
 	if err != nil {
 		return "", err
 	}
@@ -52,7 +89,7 @@ func (c *clientImpl) GetSignatureAddress(value, signature string) (string, error
 		Result: &address,
 	}
 	if err := json.Unmarshal(responseBytes, &response); err != nil {
-		return "", err
+		return "", c.unavailable(err)
 	}
 	if response.Error != nil {
 		return "", &types.BadRequestError{
@@ -62,29 +99,47 @@ func (c *clientImpl) GetSignatureAddress(value, signature string) (string, error
 	return address, nil
 }
 
-func (c *clientImpl) IsIdentity(address string) (bool, error) {
-	responseBytes, err := sendRequest(fmt.Sprintf("%v/api/identity/%v", c.apiUrl, address))
+func (c *restNode) GetIdentity(ctx context.Context, address string) (Identity, error) {
+	responseBytes, err := c.sendRequest(ctx, fmt.Sprintf("%v/api/identity/%v", c.apiUrl, address))
 	if err != nil {
-		return false, err
+		return Identity{}, err
 	}
 	var identity Identity
 	var response = Response{
 		Result: &identity,
 	}
 	if err := json.Unmarshal(responseBytes, &response); err != nil {
-		return false, err
+		return Identity{}, c.unavailable(err)
 	}
 	if response.Error != nil {
 		if response.Error.Message == "no data found" {
-			return false, nil
+			return Identity{}, nil
 		}
-		return false, errors.New(response.Error.Message)
+		return Identity{}, errors.New(response.Error.Message)
+	}
+	return identity, nil
+}
+
+func (c *restNode) LastEpoch(ctx context.Context) (uint16, error) {
+	responseBytes, err := c.sendRequest(ctx, fmt.Sprintf("%v/api/epoch/last", c.apiUrl))
+	if err != nil {
+		return 0, err
+	}
+	var epoch Epoch
+	var response = Response{
+		Result: &epoch,
+	}
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return 0, c.unavailable(err)
+	}
+	if response.Error != nil {
+		return 0, errors.New(response.Error.Message)
 	}
-	return isIdentity(identity.State), nil
+	return epoch.Epoch, nil
 }
 
-func sendRequest(req string) ([]byte, error) {
-	httpReq, err := http.NewRequest("GET", req, nil)
+func (c *restNode) sendRequest(ctx context.Context, req string) ([]byte, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, "GET", req, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -95,23 +150,23 @@ func sendRequest(req string) ([]byte, error) {
 		}
 		resp.Body.Close()
 	}()
-	httpClient := &http.Client{
-		Timeout: time.Second * 5,
-	}
-	resp, err = httpClient.Do(httpReq)
+	resp, err = c.httpClient.Do(httpReq)
 	if err == nil && resp.StatusCode != http.StatusOK {
 		err = errors.New(fmt.Sprintf("resp code %v", resp.StatusCode))
 	}
 	if err != nil {
-		return nil, err
+		return nil, c.unavailable(err)
 	}
 	respBody, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		return nil, errors.Wrap(err, "unable to read resp")
+		return nil, c.unavailable(errors.Wrap(err, "unable to read resp"))
 	}
 	return respBody, nil
 }
 
-func isIdentity(state string) bool {
-	return state == "Newbie" || state == "Verified" || state == "Human"
+func (c *restNode) unavailable(err error) error {
+	return &unavailableError{
+		url: c.apiUrl,
+		err: err,
+	}
 }
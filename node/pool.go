@@ -0,0 +1,334 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	log "github.com/inconshreveable/log15"
+	"github.com/pkg/errors"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	BreakerClosed   = "closed"
+	BreakerOpen     = "open"
+	BreakerHalfOpen = "half-open"
+)
+
+type ClientConfig struct {
+	// Timeout limits a single request to a node
+	Timeout time.Duration
+	// Retries is the number of additional attempts of a request that failed because the node is unavailable,
+	// retries go to other nodes if there are any
+	Retries int
+	// RetryBackoff is the delay before the first retry, it doubles with every next retry up to MaxRetryBackoff
+	RetryBackoff    time.Duration
+	MaxRetryBackoff time.Duration
+	// BreakerFailures is the number of consecutive failures after which the node is skipped for BreakerCooldown,
+	// then a single trial request is sent to the node to check if it is back, 0 disables the breaker
+	BreakerFailures int
+	BreakerCooldown time.Duration
+	// HealthCheckInterval is the interval of background checks of all nodes, 0 disables checks
+	HealthCheckInterval time.Duration
+}
+
+type EndpointStatus struct {
+	Url string
+	// Healthy is the result of the last health check, endpoints are healthy until the first check
+	Healthy bool
+	Breaker string
+	// ConsecutiveFailures is the number of failed requests and health checks since the last successful one
+	ConsecutiveFailures int
+	LastEpoch           uint16
+	LastError           string
+	LastCheck           time.Time
+}
+
+// endpoint is a node the client sends requests to with the circuit breaker that stops requests to the node after
+// consecutive failures
+type endpoint struct {
+	url       string
+	api       nodeApi
+	mutex     sync.Mutex
+	healthy   bool
+	lastEpoch uint16
+	lastError string
+	lastCheck time.Time
+	breaker   string
+	failures  int
+	openedAt  time.Time
+	// trial is true while the single request allowed by the half-open breaker is in progress
+	trial bool
+}
+
+// allow returns true if the breaker lets the request through, the open breaker becomes half-open after cooldown
+func (e *endpoint) allow(cooldown time.Duration) bool {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	switch e.breaker {
+	case BreakerOpen:
+		if time.Since(e.openedAt) < cooldown {
+			return false
+		}
+		e.breaker = BreakerHalfOpen
+		e.trial = true
+		return true
+	case BreakerHalfOpen:
+		if e.trial {
+			return false
+		}
+		e.trial = true
+		return true
+	default:
+		return true
+	}
+}
+
+func (e *endpoint) isHealthy() bool {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	return e.healthy
+}
+
+func (e *endpoint) onSuccess() {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	if e.breaker != BreakerClosed {
+		log.Info(fmt.Sprintf("Node %v is available", e.url))
+	}
+	e.breaker = BreakerClosed
+	e.failures = 0
+	e.trial = false
+}
+
+func (e *endpoint) onFailure(err error, threshold int) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	e.failures++
+	e.lastError = err.Error()
+	e.trial = false
+	if e.breaker == BreakerHalfOpen || e.breaker == BreakerClosed && threshold > 0 && e.failures >= threshold {
+		if e.breaker == BreakerClosed {
+			log.Warn(fmt.Sprintf("Node %v is unavailable after %v failures: %v", e.url, e.failures, err))
+		}
+		e.breaker = BreakerOpen
+		e.openedAt = time.Now()
+	}
+}
+
+// release finishes the request that was interrupted by the caller, so neither success nor failure is counted
+func (e *endpoint) release() {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	e.trial = false
+}
+
+func (e *endpoint) status() EndpointStatus {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	return EndpointStatus{
+		Url:                 e.url,
+		Healthy:             e.healthy,
+		Breaker:             e.breaker,
+		ConsecutiveFailures: e.failures,
+		LastEpoch:           e.lastEpoch,
+		LastError:           e.lastError,
+		LastCheck:           e.lastCheck,
+	}
+}
+
+// clientImpl sends requests to healthy nodes in turn, requests that failed because the node is unavailable are
+// retried on other nodes with exponential backoff
+type clientImpl struct {
+	endpoints []*endpoint
+	conf      ClientConfig
+	counter   uint32
+}
+
+// NewClient creates the client of the indexer rest api, apiUrls are urls of interchangeable indexers
+func NewClient(apiUrls []string, conf ClientConfig) Client {
+	return newClient(apiUrls, conf, func(apiUrl string) nodeApi {
+		return newRestNode(apiUrl, conf.Timeout)
+	})
+}
+
+// NewRpcClient creates the client of the idena-go node json-rpc api, apiUrls are urls of interchangeable nodes
+// that accept apiKey
+func NewRpcClient(apiUrls []string, apiKey string, conf ClientConfig) Client {
+	return newClient(apiUrls, conf, func(apiUrl string) nodeApi {
+		return newRpcNode(apiUrl, apiKey, conf.Timeout)
+	})
+}
+
+func newClient(apiUrls []string, conf ClientConfig, newApi func(apiUrl string) nodeApi) Client {
+	c := &clientImpl{
+		conf: conf,
+	}
+	for _, apiUrl := range apiUrls {
+		c.endpoints = append(c.endpoints, &endpoint{
+			url:     apiUrl,
+			api:     newApi(apiUrl),
+			healthy: true,
+			breaker: BreakerClosed,
+		})
+	}
+	if conf.HealthCheckInterval > 0 && len(c.endpoints) > 0 {
+		c.checkAll()
+		go c.loop()
+	}
+	return c
+}
+
+func (c *clientImpl) GetSignatureAddress(ctx context.Context, value, signature string) (string, error) {
+	var res string
+	err := c.call(ctx, func(api nodeApi) error {
+		var err error
+		res, err = api.GetSignatureAddress(ctx, value, signature)
+		return err
+	})
+	return res, err
+}
+
+func (c *clientImpl) GetIdentity(ctx context.Context, address string) (Identity, error) {
+	var res Identity
+	err := c.call(ctx, func(api nodeApi) error {
+		var err error
+		res, err = api.GetIdentity(ctx, address)
+		return err
+	})
+	return res, err
+}
+
+func (c *clientImpl) LastEpoch(ctx context.Context) (uint16, error) {
+	var res uint16
+	err := c.call(ctx, func(api nodeApi) error {
+		var err error
+		res, err = api.LastEpoch(ctx)
+		return err
+	})
+	return res, err
+}
+
+func (c *clientImpl) Endpoints() []EndpointStatus {
+	res := make([]EndpointStatus, 0, len(c.endpoints))
+	for _, e := range c.endpoints {
+		res = append(res, e.status())
+	}
+	return res
+}
+
+func (c *clientImpl) call(ctx context.Context, f func(api nodeApi) error) error {
+	var err error
+	tried := make(map[*endpoint]bool, len(c.endpoints))
+	for attempt := 0; attempt <= c.conf.Retries; attempt++ {
+		if attempt > 0 {
+			if waitErr := c.backoff(ctx, attempt); waitErr != nil {
+				return err
+			}
+		}
+		e := c.next(tried)
+		if e == nil {
+			if err == nil {
+				err = errors.New("no available node")
+			}
+			return err
+		}
+		tried[e] = true
+		err = f(e.api)
+		if ctx.Err() != nil {
+			e.release()
+			return err
+		}
+		if _, ok := err.(*unavailableError); !ok {
+			e.onSuccess()
+			return err
+		}
+		e.onFailure(err, c.conf.BreakerFailures)
+		log.Debug(fmt.Sprintf("Node request failed, attempt %v: %v", attempt+1, err))
+	}
+	return err
+}
+
+// next returns the node for the next attempt of the request: healthy nodes that are not tried yet go first, then
+// unhealthy ones, then nodes are tried once more. Nil is returned if breakers of all nodes are open.
+func (c *clientImpl) next(tried map[*endpoint]bool) *endpoint {
+	n := len(c.endpoints)
+	start := int(atomic.AddUint32(&c.counter, 1))
+	passes := []func(e *endpoint) bool{
+		func(e *endpoint) bool { return !tried[e] && e.isHealthy() },
+		func(e *endpoint) bool { return !tried[e] },
+		func(e *endpoint) bool { return true },
+	}
+	for _, pass := range passes {
+		for i := 0; i < n; i++ {
+			e := c.endpoints[(start+i)%n]
+			if pass(e) && e.allow(c.conf.BreakerCooldown) {
+				return e
+			}
+		}
+	}
+	return nil
+}
+
+func (c *clientImpl) backoff(ctx context.Context, attempt int) error {
+	delay := c.conf.RetryBackoff << (attempt - 1)
+	if c.conf.MaxRetryBackoff > 0 && (delay > c.conf.MaxRetryBackoff || delay < 0) {
+		delay = c.conf.MaxRetryBackoff
+	}
+	if delay <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (c *clientImpl) loop() {
+	ticker := time.NewTicker(c.conf.HealthCheckInterval)
+	defer ticker.Stop()
+	for range ticker.C {
+		c.checkAll()
+	}
+}
+
+func (c *clientImpl) checkAll() {
+	var wg sync.WaitGroup
+	for _, e := range c.endpoints {
+		wg.Add(1)
+		go func(e *endpoint) {
+			defer wg.Done()
+			c.check(e)
+		}(e)
+	}
+	wg.Wait()
+}
+
+// check requests the last epoch of the node, the successful check closes the breaker of the node
+func (c *clientImpl) check(e *endpoint) {
+	timeout := c.conf.Timeout
+	if timeout <= 0 {
+		timeout = c.conf.HealthCheckInterval
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	epoch, err := e.api.LastEpoch(ctx)
+	e.mutex.Lock()
+	e.healthy = err == nil
+	e.lastCheck = time.Now()
+	if err == nil {
+		e.lastEpoch = epoch
+	}
+	e.mutex.Unlock()
+	if err != nil {
+		log.Debug(fmt.Sprintf("Node %v check failed: %v", e.url, err))
+		e.onFailure(err, c.conf.BreakerFailures)
+		return
+	}
+	e.onSuccess()
+}
@@ -0,0 +1,140 @@
+package node
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/idena-network/idena-translation/types"
+	"github.com/pkg/errors"
+	"io/ioutil"
+	"net/http"
+	"sync/atomic"
+	"time"
+)
+
+// undefinedState is the state the node returns for addresses it has no data about
+const undefinedState = "Undefined"
+
+type rpcRequest struct {
+	JsonRpc string        `json:"jsonrpc"`
+	Id      uint64        `json:"id"`
+	Method  string        `json:"method"`
+	Params  []interface{} `json:"params"`
+	Key     string        `json:"key"`
+}
+
+// rpcNode calls the json-rpc api of the idena-go node, errors of the node availability are returned as
+// *unavailableError
+type rpcNode struct {
+	apiUrl     string
+	apiKey     string
+	httpClient *http.Client
+	counter    uint64
+}
+
+func newRpcNode(apiUrl, apiKey string, timeout time.Duration) *rpcNode {
+	return &rpcNode{
+		apiUrl: apiUrl,
+		apiKey: apiKey,
+		httpClient: &http.Client{
+			Timeout: timeout,
+		},
+	}
+}
+
+func (c *rpcNode) GetSignatureAddress(ctx context.Context, value, signature string) (string, error) {
+	var address string
+	respErr, err := c.call(ctx, "dna_signatureAddress", &address, value, signature)
+	if err != nil {
+		return "", err
+	}
+	if respErr != nil {
+		return "", &types.BadRequestError{
+			Message: respErr.Message,
+		}
+	}
+	return address, nil
+}
+
+func (c *rpcNode) GetIdentity(ctx context.Context, address string) (Identity, error) {
+	var identity Identity
+	respErr, err := c.call(ctx, "dna_identity", &identity, address)
+	if err != nil {
+		return Identity{}, err
+	}
+	if respErr != nil {
+		return Identity{}, errors.New(respErr.Message)
+	}
+	if identity.State == undefinedState {
+		return Identity{}, nil
+	}
+	return identity, nil
+}
+
+func (c *rpcNode) LastEpoch(ctx context.Context) (uint16, error) {
+	var epoch Epoch
+	respErr, err := c.call(ctx, "dna_epoch", &epoch)
+	if err != nil {
+		return 0, err
+	}
+	if respErr != nil {
+		return 0, errors.New(respErr.Message)
+	}
+	return epoch.Epoch, nil
+}
+
+// call sends the request of the method and decodes the result, the error returned by the node is returned as the
+// first value
+func (c *rpcNode) call(ctx context.Context, method string, result interface{}, params ...interface{}) (*RespError, error) {
+	if params == nil {
+		params = []interface{}{}
+	}
+	body, err := json.Marshal(rpcRequest{
+		JsonRpc: "2.0",
+		Id:      atomic.AddUint64(&c.counter, 1),
+		Method:  method,
+		Params:  params,
+		Key:     c.apiKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", c.apiUrl, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	var resp *http.Response
+	defer func() {
+		if resp == nil || resp.Body == nil {
+			return
+		}
+		resp.Body.Close()
+	}()
+	resp, err = c.httpClient.Do(httpReq)
+	if err == nil && resp.StatusCode != http.StatusOK {
+		err = errors.New(fmt.Sprintf("resp code %v", resp.StatusCode))
+	}
+	if err != nil {
+		return nil, c.unavailable(err)
+	}
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, c.unavailable(errors.Wrap(err, "unable to read resp"))
+	}
+	var response = Response{
+		Result: result,
+	}
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, c.unavailable(err)
+	}
+	return response.Error, nil
+}
+
+func (c *rpcNode) unavailable(err error) error {
+	return &unavailableError{
+		url: c.apiUrl,
+		err: err,
+	}
+}
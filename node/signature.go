@@ -0,0 +1,29 @@
+package node
+
+import (
+	"context"
+	"github.com/idena-network/idena-translation/crypto"
+	"github.com/idena-network/idena-translation/types"
+)
+
+// localSignatureClient recovers signature addresses locally the same way as the node does, other calls go to the
+// underlying client
+type localSignatureClient struct {
+	Client
+}
+
+func NewLocalSignatureClient(client Client) Client {
+	return &localSignatureClient{
+		Client: client,
+	}
+}
+
+func (c *localSignatureClient) GetSignatureAddress(ctx context.Context, value, signature string) (string, error) {
+	address, err := crypto.SignatureAddress(value, signature)
+	if err != nil {
+		return "", &types.BadRequestError{
+			Message: err.Error(),
+		}
+	}
+	return address, nil
+}
@@ -0,0 +1,3 @@
+INSERT INTO dic_languages (id, name)
+VALUES ((SELECT coalesce(max(id), 0) + 1 FROM dic_languages), $1)
+ON CONFLICT (lower(name)) DO UPDATE SET enabled = true
\ No newline at end of file
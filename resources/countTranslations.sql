@@ -0,0 +1,5 @@
+SELECT count(*)
+FROM translations t
+WHERE t.word_id = $1
+  AND t.language_id = (SELECT id FROM dic_languages WHERE lower(name) = lower($2))
+  AND t.status = 'visible'
\ No newline at end of file
@@ -0,0 +1,3 @@
+DELETE
+FROM rate_limit_buckets
+WHERE tokens + greatest(0, extract(EPOCH FROM $1::timestamptz - updated_at)) * rate >= burst
\ No newline at end of file
@@ -0,0 +1,3 @@
+UPDATE dic_languages
+SET enabled = false
+WHERE lower(name) = lower($1)
\ No newline at end of file
@@ -0,0 +1,19 @@
+SELECT t.id,
+       t.word_id,
+       l.name,
+       CASE WHEN t.status = 'visible' THEN t.name ELSE '' END,
+       CASE WHEN t.status = 'visible' THEN t.description ELSE '' END,
+       t.up_votes,
+       t.down_votes,
+       t.weighted_up_votes,
+       t.weighted_down_votes,
+       (s.score >= $4 AND NOT t.author_excluded) as confirmed,
+       t.status,
+       t.req_timestamp
+FROM translations t
+         JOIN dic_languages l ON l.id = t.language_id,
+     LATERAL (SELECT CASE WHEN $5 THEN t.weighted_up_votes - t.weighted_down_votes ELSE t.up_votes - t.down_votes END AS score) s
+WHERE lower(t.address) = lower($1)
+  AND ($2 = 0 OR t.id < $2)
+ORDER BY t.id DESC
+LIMIT $3
\ No newline at end of file
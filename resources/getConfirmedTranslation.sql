@@ -1,7 +1,15 @@
-SELECT t.id, t.name, t.description, t.up_votes, t.down_votes, (t.up_votes - t.down_votes >= $3) as confirmed
+SELECT t.id, t.name, t.description, t.up_votes, t.down_votes, t.weighted_up_votes, t.weighted_down_votes,
+       (s.score >= $3 AND NOT t.author_excluded) as confirmed,
+       CASE WHEN v.up THEN 'up' WHEN NOT v.up THEN 'down' ELSE 'none' END as my_vote,
+       lower(t.address) = lower($5) as is_mine
 FROM translations t
+         LEFT JOIN votes v
+                   ON v.translation_id = t.id AND lower(v.address) = lower($5) AND NOT v.excluded AND NOT v.retracted,
+     LATERAL (SELECT CASE WHEN $4 THEN t.weighted_up_votes - t.weighted_down_votes ELSE t.up_votes - t.down_votes END AS score) s
 WHERE t.word_id = $1
   AND t.language_id = (SELECT id FROM dic_languages WHERE lower(name) = lower($2))
-  AND t.up_votes - t.down_votes >= $3
-ORDER BY t.up_votes - t.down_votes DESC, t.id
+  AND t.status = 'visible'
+  AND s.score >= $3
+  AND NOT t.author_excluded
+ORDER BY s.score DESC, t.id
 LIMIT 1
\ No newline at end of file
@@ -0,0 +1,16 @@
+SELECT DISTINCT ON (t.word_id) t.word_id,
+                                t.id,
+                                t.name,
+                                t.description,
+                                t.up_votes,
+                                t.down_votes,
+                                t.weighted_up_votes,
+                                t.weighted_down_votes,
+                                (s.score >= $2 AND NOT t.author_excluded) as confirmed
+FROM translations t,
+     LATERAL (SELECT CASE WHEN $3 THEN t.weighted_up_votes - t.weighted_down_votes ELSE t.up_votes - t.down_votes END AS score) s
+WHERE t.language_id = (SELECT id FROM dic_languages WHERE lower(name) = lower($1))
+  AND t.status = 'visible'
+  AND s.score >= $2
+  AND NOT t.author_excluded
+ORDER BY t.word_id, s.score DESC, t.id
\ No newline at end of file
@@ -0,0 +1,19 @@
+SELECT DISTINCT ON (t.language_id, t.word_id) lower(l.name),
+                                               t.word_id,
+                                               t.id,
+                                               t.name,
+                                               t.description,
+                                               t.up_votes,
+                                               t.down_votes,
+                                               t.weighted_up_votes,
+                                               t.weighted_down_votes,
+                                               (s.score >= $3 AND NOT t.author_excluded) as confirmed
+FROM translations t
+         JOIN dic_languages l ON l.id = t.language_id,
+     LATERAL (SELECT CASE WHEN $4 THEN t.weighted_up_votes - t.weighted_down_votes ELSE t.up_votes - t.down_votes END AS score) s
+WHERE t.word_id = ANY ($1)
+  AND lower(l.name) = ANY ($2)
+  AND t.status = 'visible'
+  AND s.score >= $3
+  AND NOT t.author_excluded
+ORDER BY t.language_id, t.word_id, s.score DESC, t.id
\ No newline at end of file
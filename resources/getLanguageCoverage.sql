@@ -0,0 +1,10 @@
+SELECT l.name,
+       l.enabled,
+       count(DISTINCT t.word_id),
+       count(DISTINCT t.word_id) FILTER (WHERE s.score >= $1 AND NOT t.author_excluded)
+FROM dic_languages l
+         LEFT JOIN translations t ON t.language_id = l.id AND t.status = 'visible'
+         LEFT JOIN LATERAL (SELECT CASE WHEN $2 THEN t.weighted_up_votes - t.weighted_down_votes ELSE t.up_votes - t.down_votes END AS score) s
+                   ON true
+GROUP BY l.id, l.name, l.enabled
+ORDER BY l.id
\ No newline at end of file
@@ -0,0 +1,3 @@
+SELECT name, enabled
+FROM dic_languages
+ORDER BY id
\ No newline at end of file
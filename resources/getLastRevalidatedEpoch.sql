@@ -0,0 +1,2 @@
+SELECT max(epoch)
+FROM revalidations
\ No newline at end of file
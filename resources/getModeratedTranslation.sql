@@ -0,0 +1,7 @@
+SELECT t.id, t.word_id, l.name, t.address, t.name, t.description, t.status, r.reason, count(r.address)
+FROM translations t
+         JOIN dic_languages l ON l.id = t.language_id
+         LEFT JOIN reports r ON r.translation_id = t.id AND NOT r.resolved
+WHERE t.id = $1
+GROUP BY t.id, l.name, r.reason
+ORDER BY r.reason
\ No newline at end of file
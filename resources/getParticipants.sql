@@ -0,0 +1,8 @@
+SELECT lower(address)
+FROM votes
+WHERE NOT retracted
+UNION
+SELECT lower(address)
+FROM translations
+WHERE address <> ''
+  AND source IS NULL
\ No newline at end of file
@@ -0,0 +1,4 @@
+SELECT address, author_excluded, address <> '' AND source IS NULL
+FROM translations
+WHERE id = $1
+    FOR UPDATE
\ No newline at end of file
@@ -0,0 +1,3 @@
+SELECT id
+FROM translations
+ORDER BY id
\ No newline at end of file
@@ -0,0 +1,6 @@
+SELECT address, up, weight, excluded
+FROM votes
+WHERE translation_id = $1
+  AND NOT retracted
+ORDER BY lower(address)
+    FOR UPDATE
\ No newline at end of file
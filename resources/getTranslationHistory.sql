@@ -0,0 +1,14 @@
+SELECT r.translation_id, r.name, r.description, r.up_votes, r.down_votes, r.req_timestamp, r.replaced_at
+FROM translation_revisions r
+         JOIN translations t
+              ON t.word_id = r.word_id AND lower(t.address) = lower(r.address) AND t.language_id = r.language_id
+WHERE (r.word_id, lower(r.address), r.language_id) IN
+      (SELECT word_id, lower(address), language_id
+       FROM translations
+       WHERE id = $1
+       UNION ALL
+       SELECT word_id, lower(address), language_id
+       FROM translation_revisions
+       WHERE translation_id = $1)
+  AND t.status = 'visible'
+ORDER BY r.req_timestamp DESC
\ No newline at end of file
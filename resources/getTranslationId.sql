@@ -0,0 +1,5 @@
+SELECT t.id
+FROM translations t
+WHERE t.word_id = $1
+  AND lower(t.address) = lower($2)
+  AND t.language_id = (SELECT id FROM dic_languages WHERE lower(name) = lower($3))
\ No newline at end of file
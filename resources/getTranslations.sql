@@ -1,9 +1,16 @@
-SELECT t.id, t.name, t.description, t.up_votes, t.down_votes, (t.up_votes - t.down_votes >= $6) as confirmed
+SELECT t.id, t.name, t.description, t.up_votes, t.down_votes, t.weighted_up_votes, t.weighted_down_votes,
+       (s.score >= $6 AND NOT t.author_excluded) as confirmed,
+       CASE WHEN v.up THEN 'up' WHEN NOT v.up THEN 'down' ELSE 'none' END as my_vote,
+       lower(t.address) = lower($8) as is_mine
 FROM translations t
+         LEFT JOIN votes v
+                   ON v.translation_id = t.id AND lower(v.address) = lower($8) AND NOT v.excluded AND NOT v.retracted,
+     LATERAL (SELECT CASE WHEN $7 THEN t.weighted_up_votes - t.weighted_down_votes ELSE t.up_votes - t.down_votes END AS score) s
 WHERE t.word_id = $1
   AND t.language_id = (SELECT id FROM dic_languages WHERE lower(name) = lower($2))
+  AND t.status = 'visible'
   AND ($4 = 0
-    OR (t.up_votes - t.down_votes <= $3
-        AND t.id >= $4))
-ORDER BY t.up_votes - t.down_votes DESC, t.id
+    OR s.score < $3
+    OR (s.score = $3 AND t.id > $4))
+ORDER BY s.score DESC, t.id
 LIMIT $5
\ No newline at end of file
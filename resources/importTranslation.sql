@@ -0,0 +1,5 @@
+INSERT INTO translations (word_id, address, language_id, name, description, req_timestamp, up_votes,
+                          weighted_up_votes, source)
+SELECT $1, $2, id, $4, $5, CURRENT_TIMESTAMP, $6, $6, $7
+FROM dic_languages
+WHERE lower(name) = lower($3)
\ No newline at end of file
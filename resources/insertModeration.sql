@@ -0,0 +1,2 @@
+INSERT INTO moderations (translation_id, action)
+VALUES ($1, $2)
\ No newline at end of file
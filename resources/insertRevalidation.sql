@@ -0,0 +1,3 @@
+INSERT INTO revalidations (epoch)
+VALUES ($1)
+ON CONFLICT DO NOTHING
\ No newline at end of file
@@ -0,0 +1,4 @@
+INSERT INTO translation_revalidations (epoch, translation_id, author_state, author_excluded, prev_up_votes,
+                                       prev_down_votes, prev_weighted_up_votes, prev_weighted_down_votes, up_votes,
+                                       down_votes, weighted_up_votes, weighted_down_votes, prev_confirmed, confirmed)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
\ No newline at end of file
@@ -0,0 +1,2 @@
+INSERT INTO vote_revalidations (epoch, translation_id, address, state, up, prev_weight, weight, prev_excluded, excluded)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
\ No newline at end of file
@@ -0,0 +1,8 @@
+DROP FUNCTION IF EXISTS vote(text, integer, boolean, timestamptz);
+DROP FUNCTION IF EXISTS submit_translation(text, integer, text, text, text, timestamptz, integer);
+DROP TYPE IF EXISTS tp_vote_result;
+DROP TYPE IF EXISTS tp_submit_translation_result;
+DROP TABLE IF EXISTS votes;
+DROP TABLE IF EXISTS translations;
+DROP SEQUENCE IF EXISTS translations_id_seq;
+DROP TABLE IF EXISTS dic_languages;
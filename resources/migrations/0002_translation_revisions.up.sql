@@ -0,0 +1,90 @@
+CREATE TABLE IF NOT EXISTS translation_revisions
+(
+    translation_id integer               NOT NULL,
+    word_id        integer               NOT NULL,
+    address        character varying(42) NOT NULL,
+    language_id    smallint              NOT NULL,
+    name           character varying(30) NOT NULL,
+    description    character varying(150),
+    req_timestamp  timestamptz           NOT NULL,
+    timestamp      timestamptz           NOT NULL,
+    up_votes       integer               NOT NULL,
+    down_votes     integer               NOT NULL,
+    replaced_by    integer               NOT NULL,
+    replaced_at    timestamptz           NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT translation_revisions_pkey PRIMARY KEY (translation_id),
+    CONSTRAINT translation_revisions_language_id_fkey FOREIGN KEY (language_id)
+        REFERENCES dic_languages (id) MATCH SIMPLE
+);
+CREATE INDEX IF NOT EXISTS translation_revisions_key ON translation_revisions (word_id, lower(address), language_id, req_timestamp desc);
+
+CREATE OR REPLACE FUNCTION submit_translation(p_address text,
+                                              p_word_id integer,
+                                              p_language text,
+                                              p_name text,
+                                              p_description text,
+                                              p_req_timestamp timestamptz,
+                                              p_confirmed_rate integer) RETURNS tp_submit_translation_result
+    LANGUAGE 'plpgsql'
+AS
+$body$
+DECLARE
+    l_language_id   smallint;
+    l_rate          smallint;
+    l_id            integer;
+    l_req_timestamp timestamptz;
+    l_new_id        integer;
+BEGIN
+    SELECT id
+    INTO l_language_id
+    FROM dic_languages
+    WHERE lower(name) = lower(p_language);
+
+    if l_language_id is null then
+        return CAST(ROW (-1, 0) AS tp_submit_translation_result);
+    end if;
+
+    SELECT id, up_votes - down_votes, req_timestamp
+    INTO l_id, l_rate, l_req_timestamp
+    FROM translations
+    WHERE word_id = p_word_id
+      AND lower(address) = lower(p_address)
+      AND language_id = l_language_id;
+
+    if l_id is not null then
+        if l_rate >= p_confirmed_rate then
+            return CAST(ROW (2, 0) AS tp_submit_translation_result);
+        end if;
+        if l_req_timestamp >= p_req_timestamp then
+            return CAST(ROW (3, 0) AS tp_submit_translation_result);
+        end if;
+    end if;
+
+    l_new_id = nextval('translations_id_seq');
+
+    if l_id is not null then
+        INSERT INTO translation_revisions (translation_id, word_id, address, language_id, name, description,
+                                           req_timestamp, timestamp, up_votes, down_votes, replaced_by)
+        SELECT id,
+               word_id,
+               address,
+               language_id,
+               name,
+               description,
+               req_timestamp,
+               timestamp,
+               up_votes,
+               down_votes,
+               l_new_id
+        FROM translations
+        WHERE id = l_id;
+        DELETE FROM votes WHERE translation_id = l_id;
+        DELETE FROM translations WHERE id = l_id;
+    end if;
+
+    INSERT INTO translations (id, word_id, address, language_id, name, description, req_timestamp)
+    VALUES (l_new_id, p_word_id, p_address, l_language_id, p_name, p_description, p_req_timestamp);
+
+    return CAST(ROW (0, l_new_id) AS tp_submit_translation_result);
+END
+$body$;
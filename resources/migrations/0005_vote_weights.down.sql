@@ -0,0 +1,166 @@
+DROP FUNCTION IF EXISTS vote(text, integer, boolean, timestamptz, numeric);
+DROP FUNCTION IF EXISTS submit_translation(text, integer, text, text, text, timestamptz, integer, boolean);
+
+ALTER TYPE tp_vote_result
+    DROP ATTRIBUTE IF EXISTS weighted_up_votes,
+    DROP ATTRIBUTE IF EXISTS weighted_down_votes;
+
+CREATE OR REPLACE FUNCTION submit_translation(p_address text,
+                                              p_word_id integer,
+                                              p_language text,
+                                              p_name text,
+                                              p_description text,
+                                              p_req_timestamp timestamptz,
+                                              p_confirmed_rate integer) RETURNS tp_submit_translation_result
+    LANGUAGE 'plpgsql'
+AS
+$body$
+DECLARE
+    l_language_id   smallint;
+    l_rate          smallint;
+    l_id            integer;
+    l_req_timestamp timestamptz;
+    l_new_id        integer;
+BEGIN
+    SELECT id
+    INTO l_language_id
+    FROM dic_languages
+    WHERE lower(name) = lower(p_language)
+      AND enabled;
+
+    if l_language_id is null then
+        return CAST(ROW (-1, 0) AS tp_submit_translation_result);
+    end if;
+
+    SELECT id, up_votes - down_votes, req_timestamp
+    INTO l_id, l_rate, l_req_timestamp
+    FROM translations
+    WHERE word_id = p_word_id
+      AND lower(address) = lower(p_address)
+      AND language_id = l_language_id;
+
+    if l_id is not null then
+        if l_rate >= p_confirmed_rate then
+            return CAST(ROW (2, 0) AS tp_submit_translation_result);
+        end if;
+        if l_req_timestamp >= p_req_timestamp then
+            return CAST(ROW (3, 0) AS tp_submit_translation_result);
+        end if;
+    end if;
+
+    l_new_id = nextval('translations_id_seq');
+
+    if l_id is not null then
+        INSERT INTO translation_revisions (translation_id, word_id, address, language_id, name, description,
+                                           req_timestamp, timestamp, up_votes, down_votes, source, replaced_by)
+        SELECT id,
+               word_id,
+               address,
+               language_id,
+               name,
+               description,
+               req_timestamp,
+               timestamp,
+               up_votes,
+               down_votes,
+               source,
+               l_new_id
+        FROM translations
+        WHERE id = l_id;
+        DELETE FROM votes WHERE translation_id = l_id;
+        DELETE FROM translations WHERE id = l_id;
+    end if;
+
+    INSERT INTO translations (id, word_id, address, language_id, name, description, req_timestamp)
+    VALUES (l_new_id, p_word_id, p_address, l_language_id, p_name, p_description, p_req_timestamp);
+
+    return CAST(ROW (0, l_new_id) AS tp_submit_translation_result);
+END
+$body$;
+
+CREATE OR REPLACE FUNCTION vote(p_address text,
+                                p_translation_id integer,
+                                p_up boolean,
+                                p_req_timestamp timestamptz) RETURNS tp_vote_result
+    LANGUAGE 'plpgsql'
+AS
+$body$
+DECLARE
+    l_address        text;
+    l_up             bool;
+    l_up_change      smallint;
+    l_down_change    smallint;
+    l_req_timestamp  timestamptz;
+    l_new_up_votes   integer;
+    l_new_down_votes integer;
+BEGIN
+    SELECT address INTO l_address FROM translations WHERE id = p_translation_id;
+
+    if l_address is null then
+        return CAST(ROW (-1, 0, 0) AS tp_vote_result);
+    end if;
+
+    if l_address = p_address then
+        return CAST(ROW (1, 0, 0) AS tp_vote_result);
+    end if;
+
+    SELECT up, req_timestamp
+    INTO l_up, l_req_timestamp
+    FROM votes
+    WHERE translation_id = p_translation_id
+      AND lower(address) = lower(p_address);
+
+    if l_up is null then
+        INSERT INTO votes (translation_id, address, up, req_timestamp)
+        VALUES (p_translation_id, p_address, p_up, p_req_timestamp);
+        if p_up then
+            l_up_change = 1;
+            l_down_change = 0;
+        else
+            l_up_change = 0;
+            l_down_change = 1;
+        end if;
+    else
+        if l_up = p_up then
+            return CAST(ROW (3, 0, 0) AS tp_vote_result);
+        end if;
+
+        if l_req_timestamp >= p_req_timestamp then
+            return CAST(ROW (2, 0, 0) AS tp_vote_result);
+        end if;
+
+        UPDATE votes
+        SET up            = p_up,
+            timestamp     = CURRENT_TIMESTAMP,
+            req_timestamp = p_req_timestamp
+        WHERE translation_id = p_translation_id
+          AND lower(address) = lower(p_address);
+        if p_up then
+            l_up_change = 1;
+            l_down_change = -1;
+        else
+            l_up_change = -1;
+            l_down_change = 1;
+        end if;
+    end if;
+
+    UPDATE translations
+    SET up_votes   = up_votes + l_up_change,
+        down_votes = down_votes + l_down_change,
+        timestamp  = CURRENT_TIMESTAMP
+    WHERE id = p_translation_id
+    RETURNING up_votes, down_votes INTO l_new_up_votes, l_new_down_votes;
+
+    return CAST(ROW (0, l_new_up_votes, l_new_down_votes) AS tp_vote_result);
+END
+$body$;
+
+DROP INDEX IF EXISTS translations_weighted_key;
+ALTER TABLE translation_revisions
+    DROP COLUMN IF EXISTS weighted_up_votes,
+    DROP COLUMN IF EXISTS weighted_down_votes;
+ALTER TABLE translations
+    DROP COLUMN IF EXISTS weighted_up_votes,
+    DROP COLUMN IF EXISTS weighted_down_votes;
+ALTER TABLE votes
+    DROP COLUMN IF EXISTS weight;
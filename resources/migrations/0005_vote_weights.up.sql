@@ -0,0 +1,198 @@
+ALTER TABLE votes
+    ADD COLUMN IF NOT EXISTS weight numeric(10, 4) NOT NULL DEFAULT 1;
+ALTER TABLE translations
+    ADD COLUMN IF NOT EXISTS weighted_up_votes numeric(14, 4) NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS weighted_down_votes numeric(14, 4) NOT NULL DEFAULT 0;
+ALTER TABLE translation_revisions
+    ADD COLUMN IF NOT EXISTS weighted_up_votes numeric(14, 4) NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS weighted_down_votes numeric(14, 4) NOT NULL DEFAULT 0;
+UPDATE translations
+SET weighted_up_votes   = up_votes,
+    weighted_down_votes = down_votes;
+UPDATE translation_revisions
+SET weighted_up_votes   = up_votes,
+    weighted_down_votes = down_votes;
+CREATE INDEX IF NOT EXISTS translations_weighted_key ON translations (word_id, language_id,
+                                                                      (weighted_up_votes - weighted_down_votes) desc, id);
+
+ALTER TYPE tp_vote_result
+    ADD ATTRIBUTE weighted_up_votes numeric,
+    ADD ATTRIBUTE weighted_down_votes numeric;
+
+DROP FUNCTION IF EXISTS vote(text, integer, boolean, timestamptz);
+DROP FUNCTION IF EXISTS submit_translation(text, integer, text, text, text, timestamptz, integer);
+
+CREATE OR REPLACE FUNCTION submit_translation(p_address text,
+                                              p_word_id integer,
+                                              p_language text,
+                                              p_name text,
+                                              p_description text,
+                                              p_req_timestamp timestamptz,
+                                              p_confirmed_rate integer,
+                                              p_weighted boolean) RETURNS tp_submit_translation_result
+    LANGUAGE 'plpgsql'
+AS
+$body$
+DECLARE
+    l_language_id   smallint;
+    l_rate          numeric;
+    l_id            integer;
+    l_req_timestamp timestamptz;
+    l_new_id        integer;
+BEGIN
+    SELECT id
+    INTO l_language_id
+    FROM dic_languages
+    WHERE lower(name) = lower(p_language)
+      AND enabled;
+
+    if l_language_id is null then
+        return CAST(ROW (-1, 0) AS tp_submit_translation_result);
+    end if;
+
+    SELECT id,
+           CASE WHEN p_weighted THEN weighted_up_votes - weighted_down_votes ELSE up_votes - down_votes END,
+           req_timestamp
+    INTO l_id, l_rate, l_req_timestamp
+    FROM translations
+    WHERE word_id = p_word_id
+      AND lower(address) = lower(p_address)
+      AND language_id = l_language_id;
+
+    if l_id is not null then
+        if l_rate >= p_confirmed_rate then
+            return CAST(ROW (2, 0) AS tp_submit_translation_result);
+        end if;
+        if l_req_timestamp >= p_req_timestamp then
+            return CAST(ROW (3, 0) AS tp_submit_translation_result);
+        end if;
+    end if;
+
+    l_new_id = nextval('translations_id_seq');
+
+    if l_id is not null then
+        INSERT INTO translation_revisions (translation_id, word_id, address, language_id, name, description,
+                                           req_timestamp, timestamp, up_votes, down_votes, weighted_up_votes,
+                                           weighted_down_votes, source, replaced_by)
+        SELECT id,
+               word_id,
+               address,
+               language_id,
+               name,
+               description,
+               req_timestamp,
+               timestamp,
+               up_votes,
+               down_votes,
+               weighted_up_votes,
+               weighted_down_votes,
+               source,
+               l_new_id
+        FROM translations
+        WHERE id = l_id;
+        DELETE FROM votes WHERE translation_id = l_id;
+        DELETE FROM translations WHERE id = l_id;
+    end if;
+
+    INSERT INTO translations (id, word_id, address, language_id, name, description, req_timestamp)
+    VALUES (l_new_id, p_word_id, p_address, l_language_id, p_name, p_description, p_req_timestamp);
+
+    return CAST(ROW (0, l_new_id) AS tp_submit_translation_result);
+END
+$body$;
+
+CREATE OR REPLACE FUNCTION vote(p_address text,
+                                p_translation_id integer,
+                                p_up boolean,
+                                p_req_timestamp timestamptz,
+                                p_weight numeric) RETURNS tp_vote_result
+    LANGUAGE 'plpgsql'
+AS
+$body$
+DECLARE
+    l_address                 text;
+    l_up                      bool;
+    l_weight                  numeric;
+    l_up_change               smallint;
+    l_down_change             smallint;
+    l_weighted_up_change      numeric;
+    l_weighted_down_change    numeric;
+    l_req_timestamp           timestamptz;
+    l_new_up_votes            integer;
+    l_new_down_votes          integer;
+    l_new_weighted_up_votes   numeric;
+    l_new_weighted_down_votes numeric;
+BEGIN
+    SELECT address INTO l_address FROM translations WHERE id = p_translation_id;
+
+    if l_address is null then
+        return CAST(ROW (-1, 0, 0, 0, 0) AS tp_vote_result);
+    end if;
+
+    if l_address = p_address then
+        return CAST(ROW (1, 0, 0, 0, 0) AS tp_vote_result);
+    end if;
+
+    SELECT up, weight, req_timestamp
+    INTO l_up, l_weight, l_req_timestamp
+    FROM votes
+    WHERE translation_id = p_translation_id
+      AND lower(address) = lower(p_address);
+
+    if l_up is null then
+        INSERT INTO votes (translation_id, address, up, weight, req_timestamp)
+        VALUES (p_translation_id, p_address, p_up, p_weight, p_req_timestamp);
+        if p_up then
+            l_up_change = 1;
+            l_down_change = 0;
+            l_weighted_up_change = p_weight;
+            l_weighted_down_change = 0;
+        else
+            l_up_change = 0;
+            l_down_change = 1;
+            l_weighted_up_change = 0;
+            l_weighted_down_change = p_weight;
+        end if;
+    else
+        if l_up = p_up then
+            return CAST(ROW (3, 0, 0, 0, 0) AS tp_vote_result);
+        end if;
+
+        if l_req_timestamp >= p_req_timestamp then
+            return CAST(ROW (2, 0, 0, 0, 0) AS tp_vote_result);
+        end if;
+
+        UPDATE votes
+        SET up            = p_up,
+            weight        = p_weight,
+            timestamp     = CURRENT_TIMESTAMP,
+            req_timestamp = p_req_timestamp
+        WHERE translation_id = p_translation_id
+          AND lower(address) = lower(p_address);
+        if p_up then
+            l_up_change = 1;
+            l_down_change = -1;
+            l_weighted_up_change = p_weight;
+            l_weighted_down_change = -l_weight;
+        else
+            l_up_change = -1;
+            l_down_change = 1;
+            l_weighted_up_change = -l_weight;
+            l_weighted_down_change = p_weight;
+        end if;
+    end if;
+
+    UPDATE translations
+    SET up_votes            = up_votes + l_up_change,
+        down_votes          = down_votes + l_down_change,
+        weighted_up_votes   = weighted_up_votes + l_weighted_up_change,
+        weighted_down_votes = weighted_down_votes + l_weighted_down_change,
+        timestamp           = CURRENT_TIMESTAMP
+    WHERE id = p_translation_id
+    RETURNING up_votes, down_votes, weighted_up_votes, weighted_down_votes
+        INTO l_new_up_votes, l_new_down_votes, l_new_weighted_up_votes, l_new_weighted_down_votes;
+
+    return CAST(ROW (0, l_new_up_votes, l_new_down_votes, l_new_weighted_up_votes,
+                     l_new_weighted_down_votes) AS tp_vote_result);
+END
+$body$;
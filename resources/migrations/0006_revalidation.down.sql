@@ -0,0 +1,104 @@
+CREATE OR REPLACE FUNCTION vote(p_address text,
+                                p_translation_id integer,
+                                p_up boolean,
+                                p_req_timestamp timestamptz,
+                                p_weight numeric) RETURNS tp_vote_result
+    LANGUAGE 'plpgsql'
+AS
+$body$
+DECLARE
+    l_address                 text;
+    l_up                      bool;
+    l_weight                  numeric;
+    l_up_change               smallint;
+    l_down_change             smallint;
+    l_weighted_up_change      numeric;
+    l_weighted_down_change    numeric;
+    l_req_timestamp           timestamptz;
+    l_new_up_votes            integer;
+    l_new_down_votes          integer;
+    l_new_weighted_up_votes   numeric;
+    l_new_weighted_down_votes numeric;
+BEGIN
+    SELECT address INTO l_address FROM translations WHERE id = p_translation_id;
+
+    if l_address is null then
+        return CAST(ROW (-1, 0, 0, 0, 0) AS tp_vote_result);
+    end if;
+
+    if l_address = p_address then
+        return CAST(ROW (1, 0, 0, 0, 0) AS tp_vote_result);
+    end if;
+
+    SELECT up, weight, req_timestamp
+    INTO l_up, l_weight, l_req_timestamp
+    FROM votes
+    WHERE translation_id = p_translation_id
+      AND lower(address) = lower(p_address);
+
+    if l_up is null then
+        INSERT INTO votes (translation_id, address, up, weight, req_timestamp)
+        VALUES (p_translation_id, p_address, p_up, p_weight, p_req_timestamp);
+        if p_up then
+            l_up_change = 1;
+            l_down_change = 0;
+            l_weighted_up_change = p_weight;
+            l_weighted_down_change = 0;
+        else
+            l_up_change = 0;
+            l_down_change = 1;
+            l_weighted_up_change = 0;
+            l_weighted_down_change = p_weight;
+        end if;
+    else
+        if l_up = p_up then
+            return CAST(ROW (3, 0, 0, 0, 0) AS tp_vote_result);
+        end if;
+
+        if l_req_timestamp >= p_req_timestamp then
+            return CAST(ROW (2, 0, 0, 0, 0) AS tp_vote_result);
+        end if;
+
+        UPDATE votes
+        SET up            = p_up,
+            weight        = p_weight,
+            timestamp     = CURRENT_TIMESTAMP,
+            req_timestamp = p_req_timestamp
+        WHERE translation_id = p_translation_id
+          AND lower(address) = lower(p_address);
+        if p_up then
+            l_up_change = 1;
+            l_down_change = -1;
+            l_weighted_up_change = p_weight;
+            l_weighted_down_change = -l_weight;
+        else
+            l_up_change = -1;
+            l_down_change = 1;
+            l_weighted_up_change = -l_weight;
+            l_weighted_down_change = p_weight;
+        end if;
+    end if;
+
+    UPDATE translations
+    SET up_votes            = up_votes + l_up_change,
+        down_votes          = down_votes + l_down_change,
+        weighted_up_votes   = weighted_up_votes + l_weighted_up_change,
+        weighted_down_votes = weighted_down_votes + l_weighted_down_change,
+        timestamp           = CURRENT_TIMESTAMP
+    WHERE id = p_translation_id
+    RETURNING up_votes, down_votes, weighted_up_votes, weighted_down_votes
+        INTO l_new_up_votes, l_new_down_votes, l_new_weighted_up_votes, l_new_weighted_down_votes;
+
+    return CAST(ROW (0, l_new_up_votes, l_new_down_votes, l_new_weighted_up_votes,
+                     l_new_weighted_down_votes) AS tp_vote_result);
+END
+$body$;
+
+DROP TABLE IF EXISTS translation_revalidations;
+DROP TABLE IF EXISTS vote_revalidations;
+DROP TABLE IF EXISTS revalidations;
+
+ALTER TABLE translations
+    DROP COLUMN IF EXISTS author_excluded;
+ALTER TABLE votes
+    DROP COLUMN IF EXISTS excluded;
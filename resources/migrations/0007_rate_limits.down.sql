@@ -0,0 +1,3 @@
+DROP FUNCTION IF EXISTS take_rate_limit_token(text, double precision, integer, timestamptz);
+
+DROP TABLE IF EXISTS rate_limit_buckets;
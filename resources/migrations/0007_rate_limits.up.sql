@@ -0,0 +1,38 @@
+CREATE TABLE IF NOT EXISTS rate_limit_buckets
+(
+    key        character varying(200) NOT NULL,
+    tokens     double precision       NOT NULL,
+    rate       double precision       NOT NULL,
+    burst      integer                NOT NULL,
+    updated_at timestamptz            NOT NULL,
+    CONSTRAINT rate_limit_buckets_pkey PRIMARY KEY (key)
+);
+
+CREATE OR REPLACE FUNCTION take_rate_limit_token(p_key text,
+                                                 p_rate double precision,
+                                                 p_burst integer,
+                                                 p_now timestamptz) RETURNS double precision
+    LANGUAGE 'plpgsql'
+AS
+$body$
+DECLARE
+    l_tokens double precision;
+BEGIN
+    INSERT INTO rate_limit_buckets AS b (key, tokens, rate, burst, updated_at)
+    VALUES (p_key, p_burst, p_rate, p_burst, p_now)
+    ON CONFLICT (key) DO UPDATE SET tokens     = least(p_burst, b.tokens +
+                                                                greatest(0, extract(EPOCH FROM p_now - b.updated_at)) *
+                                                                p_rate),
+                                    rate       = p_rate,
+                                    burst      = p_burst,
+                                    updated_at = greatest(b.updated_at, p_now)
+    RETURNING tokens INTO l_tokens;
+
+    if l_tokens >= 1 then
+        UPDATE rate_limit_buckets SET tokens = tokens - 1 WHERE key = p_key;
+        return 0;
+    end if;
+
+    return (1 - l_tokens) / p_rate;
+END
+$body$;
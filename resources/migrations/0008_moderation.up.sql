@@ -0,0 +1,176 @@
+ALTER TABLE translations
+    ADD COLUMN IF NOT EXISTS status character varying(10) NOT NULL DEFAULT 'visible';
+
+CREATE TABLE IF NOT EXISTS reports
+(
+    translation_id integer               NOT NULL,
+    address        character varying(42) NOT NULL,
+    reason         character varying(20) NOT NULL,
+    req_timestamp  timestamptz           NOT NULL,
+    timestamp      timestamptz           NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    resolved       boolean               NOT NULL DEFAULT false,
+    CONSTRAINT reports_pkey PRIMARY KEY (translation_id, address)
+);
+
+CREATE TABLE IF NOT EXISTS moderations
+(
+    translation_id integer               NOT NULL,
+    action         character varying(10) NOT NULL,
+    timestamp      timestamptz           NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS moderations_translation_id_key ON moderations (translation_id);
+
+DO
+$$
+    BEGIN
+        CREATE TYPE tp_report_result AS
+        (
+            res_code smallint,
+            status   character varying(10)
+        );
+    EXCEPTION
+        WHEN duplicate_object THEN null;
+    END
+$$;
+
+CREATE OR REPLACE FUNCTION submit_translation(p_address text,
+                                              p_word_id integer,
+                                              p_language text,
+                                              p_name text,
+                                              p_description text,
+                                              p_req_timestamp timestamptz,
+                                              p_confirmed_rate integer,
+                                              p_weighted boolean) RETURNS tp_submit_translation_result
+    LANGUAGE 'plpgsql'
+AS
+$body$
+DECLARE
+    l_language_id   smallint;
+    l_rate          numeric;
+    l_id            integer;
+    l_req_timestamp timestamptz;
+    l_status        character varying(10);
+    l_new_id        integer;
+BEGIN
+    SELECT id
+    INTO l_language_id
+    FROM dic_languages
+    WHERE lower(name) = lower(p_language)
+      AND enabled;
+
+    if l_language_id is null then
+        return CAST(ROW (-1, 0) AS tp_submit_translation_result);
+    end if;
+
+    SELECT id,
+           CASE WHEN p_weighted THEN weighted_up_votes - weighted_down_votes ELSE up_votes - down_votes END,
+           req_timestamp,
+           status
+    INTO l_id, l_rate, l_req_timestamp, l_status
+    FROM translations
+    WHERE word_id = p_word_id
+      AND lower(address) = lower(p_address)
+      AND language_id = l_language_id;
+
+    if l_id is not null then
+        if l_rate >= p_confirmed_rate then
+            return CAST(ROW (2, 0) AS tp_submit_translation_result);
+        end if;
+        if l_req_timestamp >= p_req_timestamp then
+            return CAST(ROW (3, 0) AS tp_submit_translation_result);
+        end if;
+    end if;
+
+    l_new_id = nextval('translations_id_seq');
+
+    if l_id is not null then
+        INSERT INTO translation_revisions (translation_id, word_id, address, language_id, name, description,
+                                           req_timestamp, timestamp, up_votes, down_votes, weighted_up_votes,
+                                           weighted_down_votes, source, replaced_by)
+        SELECT id,
+               word_id,
+               address,
+               language_id,
+               name,
+               description,
+               req_timestamp,
+               timestamp,
+               up_votes,
+               down_votes,
+               weighted_up_votes,
+               weighted_down_votes,
+               source,
+               l_new_id
+        FROM translations
+        WHERE id = l_id;
+        DELETE FROM votes WHERE translation_id = l_id;
+        DELETE FROM translations WHERE id = l_id;
+    end if;
+
+    -- The moderation status and reports are kept, so a hidden or removed translation can't be reset by resubmit
+    INSERT INTO translations (id, word_id, address, language_id, name, description, req_timestamp, status)
+    VALUES (l_new_id, p_word_id, p_address, l_language_id, p_name, p_description, p_req_timestamp,
+            coalesce(l_status, 'visible'));
+
+    if l_id is not null then
+        UPDATE reports SET translation_id = l_new_id WHERE translation_id = l_id;
+    end if;
+
+    return CAST(ROW (0, l_new_id) AS tp_submit_translation_result);
+END
+$body$;
+
+CREATE OR REPLACE FUNCTION report(p_address text,
+                                  p_translation_id integer,
+                                  p_reason text,
+                                  p_req_timestamp timestamptz,
+                                  p_hide_threshold integer) RETURNS tp_report_result
+    LANGUAGE 'plpgsql'
+AS
+$body$
+DECLARE
+    l_address  text;
+    l_status   character varying(10);
+    l_resolved boolean;
+    l_reports  integer;
+BEGIN
+    SELECT address, status INTO l_address, l_status FROM translations WHERE id = p_translation_id FOR UPDATE;
+
+    if l_address is null or l_status = 'removed' then
+        return CAST(ROW (-1, '') AS tp_report_result);
+    end if;
+
+    if lower(l_address) = lower(p_address) then
+        return CAST(ROW (1, l_status) AS tp_report_result);
+    end if;
+
+    SELECT resolved
+    INTO l_resolved
+    FROM reports
+    WHERE translation_id = p_translation_id
+      AND address = lower(p_address);
+
+    if l_resolved is not null and not l_resolved then
+        return CAST(ROW (2, l_status) AS tp_report_result);
+    end if;
+
+    -- The report resolved by the moderator is replaced, so the address can report the translation again
+    INSERT INTO reports (translation_id, address, reason, req_timestamp)
+    VALUES (p_translation_id, lower(p_address), p_reason, p_req_timestamp)
+    ON CONFLICT (translation_id, address) DO UPDATE SET reason        = excluded.reason,
+                                                        req_timestamp = excluded.req_timestamp,
+                                                        timestamp     = CURRENT_TIMESTAMP,
+                                                        resolved      = false;
+
+    if l_status = 'visible' and p_hide_threshold > 0 then
+        SELECT count(*) INTO l_reports FROM reports WHERE translation_id = p_translation_id AND NOT resolved;
+        if l_reports >= p_hide_threshold then
+            UPDATE translations SET status = 'hidden' WHERE id = p_translation_id;
+            INSERT INTO moderations (translation_id, action) VALUES (p_translation_id, 'hide');
+            l_status = 'hidden';
+        end if;
+    end if;
+
+    return CAST(ROW (0, l_status) AS tp_report_result);
+END
+$body$;
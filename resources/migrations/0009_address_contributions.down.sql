@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS votes_address_key;
+DROP INDEX IF EXISTS translations_address_key;
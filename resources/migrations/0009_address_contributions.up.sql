@@ -0,0 +1,2 @@
+CREATE INDEX IF NOT EXISTS translations_address_key ON translations (lower(address), id desc);
+CREATE INDEX IF NOT EXISTS votes_address_key ON votes (lower(address), translation_id desc);
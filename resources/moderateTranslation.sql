@@ -0,0 +1,5 @@
+UPDATE translations
+SET status = $2
+WHERE id = $1
+  AND status <> 'removed'
+RETURNING id
\ No newline at end of file
@@ -0,0 +1,2 @@
+SELECT ((t.val)::tp_report_result).res_code, ((t.val)::tp_report_result).status
+FROM (SELECT report($1, $2, $3, $4, $5) as val) t
\ No newline at end of file
@@ -0,0 +1,4 @@
+UPDATE reports
+SET resolved = true
+WHERE translation_id = $1
+  AND NOT resolved
\ No newline at end of file
@@ -0,0 +1,6 @@
+SELECT ((t.val)::tp_vote_result).res_code,
+       ((t.val)::tp_vote_result).up_votes,
+       ((t.val)::tp_vote_result).down_votes,
+       ((t.val)::tp_vote_result).weighted_up_votes,
+       ((t.val)::tp_vote_result).weighted_down_votes
+FROM (SELECT retract_vote($1, $2, $3) as val) t
\ No newline at end of file
@@ -0,0 +1,12 @@
+UPDATE translations t
+SET up_votes            = t.up_votes + $2,
+    down_votes          = t.down_votes + $3,
+    weighted_up_votes   = t.weighted_up_votes + $4,
+    weighted_down_votes = t.weighted_down_votes + $5,
+    author_excluded     = $6
+FROM (SELECT id, up_votes, down_votes, weighted_up_votes, weighted_down_votes, author_excluded
+      FROM translations
+      WHERE id = $1) p
+WHERE t.id = p.id
+RETURNING p.up_votes, p.down_votes, p.weighted_up_votes, p.weighted_down_votes, p.author_excluded,
+    t.up_votes, t.down_votes, t.weighted_up_votes, t.weighted_down_votes
\ No newline at end of file
@@ -0,0 +1,5 @@
+UPDATE votes
+SET weight   = $3,
+    excluded = $4
+WHERE translation_id = $1
+  AND lower(address) = lower($2)
\ No newline at end of file
@@ -1,3 +1,3 @@
 SELECT ((t.val)::tp_submit_translation_result).res_code,
        ((t.val)::tp_submit_translation_result).translation_id
-FROM (SELECT submit_translation($1, $2, $3, $4, $5, $6, $7) as val) t
\ No newline at end of file
+FROM (SELECT submit_translation($1, $2, $3, $4, $5, $6, $7, $8) as val) t
\ No newline at end of file
@@ -0,0 +1 @@
+SELECT take_rate_limit_token($1, $2, $3, $4)
\ No newline at end of file
@@ -0,0 +1,86 @@
+package server
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// TrustedProxies are reverse proxies whose X-Forwarded-For header is trusted
+type TrustedProxies []*net.IPNet
+
+// ParseTrustedProxies parses ip addresses and CIDR ranges of proxies
+func ParseTrustedProxies(values []string) (TrustedProxies, error) {
+	res := make(TrustedProxies, 0, len(values))
+	for _, value := range values {
+		if !strings.Contains(value, "/") {
+			ip := net.ParseIP(value)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid trusted proxy '%v'", value)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			res = append(res, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, ipNet, err := net.ParseCIDR(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy '%v'", value)
+		}
+		res = append(res, ipNet)
+	}
+	return res, nil
+}
+
+func (p TrustedProxies) contains(ip net.IP) bool {
+	for _, ipNet := range p {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// ClientIp returns the ip of the client. X-Forwarded-For is only honoured if the request comes from a trusted proxy,
+// the rightmost hop that is not a trusted proxy is the client since proxies append addresses of their peers and
+// the leftmost hops are sent by the client.
+func (p TrustedProxies) ClientIp(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return host
+	}
+	if !p.contains(ip) {
+		return ip.String()
+	}
+	var hops []string
+	for _, header := range r.Header.Values("X-Forwarded-For") {
+		hops = append(hops, strings.Split(header, ",")...)
+	}
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(hops[i])
+		hopIp := net.ParseIP(hop)
+		if hopIp == nil {
+			// The hop is not added by a trusted proxy, so the last trusted proxy is the client
+			break
+		}
+		ip = hopIp
+		if !p.contains(hopIp) {
+			break
+		}
+	}
+	return ip.String()
+}
+
+// GetIP returns the ip of the peer, X-Forwarded-For is ignored since there are no trusted proxies.
+//
+// Deprecated: use ClientIp of TrustedProxies parsed from the server config to honour X-Forwarded-For of proxies.
+func GetIP(r *http.Request) string {
+	return TrustedProxies(nil).ClientIp(r)
+}
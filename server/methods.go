@@ -4,7 +4,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/gorilla/mux"
+	"github.com/idena-network/idena-translation/core/export"
+	"github.com/idena-network/idena-translation/core/importer"
 	"github.com/idena-network/idena-translation/types"
+	log "github.com/inconshreveable/log15"
 	"github.com/pkg/errors"
 	"io/ioutil"
 	"net/http"
@@ -16,6 +19,7 @@ import (
 // @Summary Create or update translation
 // @Param translation body types.SubmitTranslationRequest true "translation details"
 // @Success 200 {object} types.SubmitTranslationResponse
+// @Header 200 {integer} Retry-After "seconds after which the rate limited request can be repeated"
 // @Failure 400 {object} types.ErrorResponse
 // @Failure 500 {object} types.ErrorResponse
 // @Router /translation [post]
@@ -31,15 +35,12 @@ func (s *Server) submitTranslation(w http.ResponseWriter, r *http.Request) {
 		writeErrResponse(w, reqId, http.StatusBadRequest, err.Error())
 		return
 	}
-	response, err := s.engine.SubmitTranslation(request)
+	response, err := s.engine.SubmitTranslation(r.Context(), request)
 	if err != nil {
-		if _, ok := err.(*types.BadRequestError); ok {
-			writeErrResponse(w, reqId, http.StatusBadRequest, err.Error())
-			return
-		}
-		writeErrResponse(w, reqId, http.StatusInternalServerError, err.Error())
+		writeEngineErrResponse(w, r, reqId, err)
 		return
 	}
+	setRetryAfter(w, response.ResCode, response.RetryAfter)
 	writeResponse(w, reqId, response)
 }
 
@@ -48,9 +49,12 @@ func (s *Server) submitTranslation(w http.ResponseWriter, r *http.Request) {
 // @Summary Get translations sorted by rating
 // @Param word path integer true "word id"
 // @Param language path string true "language"
-// @Param continuation-token header string false "continuation token to get next translations"
+// @Param continuation-token header string false "continuation token to get next or previous translations"
+// @Param total query boolean false "return total number of translations"
+// @Param address query string false "address to return its votes and translations"
 // @Success 200 {object} types.GetTranslationsResponse
-// @Header 200 {string} continuation-token "continuation token"
+// @Header 200 {string} continuation-token "continuation token of the next page"
+// @Header 200 {string} prev-continuation-token "continuation token of the previous page"
 // @Failure 400 {object} types.ErrorResponse
 // @Failure 500 {object} types.ErrorResponse
 // @Router /word/{word}/language/{language}/translations [get]
@@ -62,17 +66,17 @@ func (s *Server) getTranslations(w http.ResponseWriter, r *http.Request) {
 		writeErrResponse(w, reqId, http.StatusBadRequest, err.Error())
 		return
 	}
-	response, continuationToken, err := s.engine.GetTranslations(uint32(wordId), mux.Vars(r)["language"], r.Header.Get("continuation-token"))
+	withTotal, _ := strconv.ParseBool(r.Form.Get("total"))
+	response, tokens, err := s.engine.GetTranslations(r.Context(), uint32(wordId), mux.Vars(r)["language"], r.Header.Get("continuation-token"), withTotal, r.Form.Get("address"))
 	if err != nil {
-		if _, ok := err.(*types.BadRequestError); ok {
-			writeErrResponse(w, reqId, http.StatusBadRequest, err.Error())
-			return
-		}
-		writeErrResponse(w, reqId, http.StatusInternalServerError, err.Error())
+		writeEngineErrResponse(w, r, reqId, err)
 		return
 	}
-	if len(continuationToken) > 0 {
-		w.Header().Set("continuation-token", continuationToken)
+	if len(tokens.Next) > 0 {
+		w.Header().Set("continuation-token", tokens.Next)
+	}
+	if len(tokens.Prev) > 0 {
+		w.Header().Set("prev-continuation-token", tokens.Prev)
 	}
 	writeResponse(w, reqId, response)
 }
@@ -90,6 +94,7 @@ func toUint(vars map[string]string, name string) (uint64, error) {
 // @Summary Vote for or against translation
 // @Param vote body types.VoteRequest true "vote details"
 // @Success 200 {object} types.VoteResponse
+// @Header 200 {integer} Retry-After "seconds after which the rate limited request can be repeated"
 // @Failure 400 {object} types.ErrorResponse
 // @Failure 500 {object} types.ErrorResponse
 // @Router /vote [post]
@@ -105,15 +110,42 @@ func (s *Server) vote(w http.ResponseWriter, r *http.Request) {
 		writeErrResponse(w, reqId, http.StatusBadRequest, err.Error())
 		return
 	}
-	response, err := s.engine.Vote(request)
+	response, err := s.engine.Vote(r.Context(), request)
+	if err != nil {
+		writeEngineErrResponse(w, r, reqId, err)
+		return
+	}
+	setRetryAfter(w, response.ResCode, response.RetryAfter)
+	writeResponse(w, reqId, response)
+}
+
+// @Tags Translation
+// @Id retractVote
+// @Summary Retract vote for or against translation
+// @Param retraction body types.RetractVoteRequest true "retraction details"
+// @Success 200 {object} types.RetractVoteResponse
+// @Header 200 {integer} Retry-After "seconds after which the rate limited request can be repeated"
+// @Failure 400 {object} types.ErrorResponse
+// @Failure 500 {object} types.ErrorResponse
+// @Router /vote/retract [post]
+func (s *Server) retractVote(w http.ResponseWriter, r *http.Request) {
+	reqId, _ := r.Context().Value("reqId").(int)
+	body, err := ioutil.ReadAll(r.Body)
 	if err != nil {
-		if _, ok := err.(*types.BadRequestError); ok {
-			writeErrResponse(w, reqId, http.StatusBadRequest, err.Error())
-			return
-		}
 		writeErrResponse(w, reqId, http.StatusInternalServerError, err.Error())
 		return
 	}
+	request := types.RetractVoteRequest{}
+	if err := json.Unmarshal(body, &request); err != nil {
+		writeErrResponse(w, reqId, http.StatusBadRequest, err.Error())
+		return
+	}
+	response, err := s.engine.RetractVote(r.Context(), request)
+	if err != nil {
+		writeEngineErrResponse(w, r, reqId, err)
+		return
+	}
+	setRetryAfter(w, response.ResCode, response.RetryAfter)
 	writeResponse(w, reqId, response)
 }
 
@@ -122,6 +154,7 @@ func (s *Server) vote(w http.ResponseWriter, r *http.Request) {
 // @Summary Get confirmed translation
 // @Param word path integer true "word id"
 // @Param language path string true "language"
+// @Param address query string false "address to return its vote and translation"
 // @Success 200 {object} types.GetConfirmedTranslationResponse
 // @Failure 400 {object} types.ErrorResponse
 // @Failure 500 {object} types.ErrorResponse
@@ -134,10 +167,372 @@ func (s *Server) confirmedTranslation(w http.ResponseWriter, r *http.Request) {
 		writeErrResponse(w, reqId, http.StatusBadRequest, err.Error())
 		return
 	}
-	response, err := s.engine.GetConfirmedTranslation(uint32(wordId), mux.Vars(r)["language"])
+	response, err := s.engine.GetConfirmedTranslation(r.Context(), uint32(wordId), mux.Vars(r)["language"], r.Form.Get("address"))
+	if err != nil {
+		writeEngineErrResponse(w, r, reqId, err)
+		return
+	}
+	writeResponse(w, reqId, response)
+}
+
+// @Tags Translation
+// @Id getConfirmedTranslationsByWords
+// @Summary Get confirmed translations of many words in one or more languages
+// @Param request body types.GetConfirmedTranslationsByWordsRequest true "word ids and languages"
+// @Success 200 {object} types.GetConfirmedTranslationsByWordsResponse
+// @Failure 400 {object} types.ErrorResponse
+// @Failure 500 {object} types.ErrorResponse
+// @Router /confirmed-translations [post]
+func (s *Server) confirmedTranslationsByWords(w http.ResponseWriter, r *http.Request) {
+	reqId, _ := r.Context().Value("reqId").(int)
+	body, err := ioutil.ReadAll(r.Body)
 	if err != nil {
 		writeErrResponse(w, reqId, http.StatusInternalServerError, err.Error())
 		return
 	}
+	request := types.GetConfirmedTranslationsByWordsRequest{}
+	if err := json.Unmarshal(body, &request); err != nil {
+		writeErrResponse(w, reqId, http.StatusBadRequest, err.Error())
+		return
+	}
+	response, err := s.engine.GetConfirmedTranslationsByWords(r.Context(), request)
+	if err != nil {
+		writeEngineErrResponse(w, r, reqId, err)
+		return
+	}
+	writeResponse(w, reqId, response)
+}
+
+// @Tags Translation
+// @Id getTranslationHistory
+// @Summary Get earlier versions of translation replaced by resubmissions
+// @Param id path string true "translation id"
+// @Success 200 {object} types.GetTranslationHistoryResponse
+// @Failure 400 {object} types.ErrorResponse
+// @Failure 500 {object} types.ErrorResponse
+// @Router /translation/{id}/history [get]
+func (s *Server) translationHistory(w http.ResponseWriter, r *http.Request) {
+	reqId, _ := r.Context().Value("reqId").(int)
+	response, err := s.engine.GetTranslationHistory(r.Context(), mux.Vars(r)["id"])
+	if err != nil {
+		writeEngineErrResponse(w, r, reqId, err)
+		return
+	}
 	writeResponse(w, reqId, response)
 }
+
+// @Tags Translation
+// @Id getAddressTranslations
+// @Summary Get translations submitted by the address, the latest first
+// @Param address path string true "address"
+// @Param continuation-token header string false "continuation token to get next translations"
+// @Success 200 {object} types.GetAddressTranslationsResponse
+// @Header 200 {string} continuation-token "continuation token of the next page"
+// @Failure 400 {object} types.ErrorResponse
+// @Failure 500 {object} types.ErrorResponse
+// @Router /address/{address}/translations [get]
+func (s *Server) addressTranslations(w http.ResponseWriter, r *http.Request) {
+	reqId, _ := r.Context().Value("reqId").(int)
+	response, tokens, err := s.engine.GetAddressTranslations(r.Context(), mux.Vars(r)["address"], r.Header.Get("continuation-token"))
+	if err != nil {
+		writeEngineErrResponse(w, r, reqId, err)
+		return
+	}
+	if len(tokens.Next) > 0 {
+		w.Header().Set("continuation-token", tokens.Next)
+	}
+	writeResponse(w, reqId, response)
+}
+
+// @Tags Translation
+// @Id getAddressVotes
+// @Summary Get votes of the address with voted translations, votes for the latest translations first
+// @Param address path string true "address"
+// @Param continuation-token header string false "continuation token to get next votes"
+// @Success 200 {object} types.GetAddressVotesResponse
+// @Header 200 {string} continuation-token "continuation token of the next page"
+// @Failure 400 {object} types.ErrorResponse
+// @Failure 500 {object} types.ErrorResponse
+// @Router /address/{address}/votes [get]
+func (s *Server) addressVotes(w http.ResponseWriter, r *http.Request) {
+	reqId, _ := r.Context().Value("reqId").(int)
+	response, tokens, err := s.engine.GetAddressVotes(r.Context(), mux.Vars(r)["address"], r.Header.Get("continuation-token"))
+	if err != nil {
+		writeEngineErrResponse(w, r, reqId, err)
+		return
+	}
+	if len(tokens.Next) > 0 {
+		w.Header().Set("continuation-token", tokens.Next)
+	}
+	writeResponse(w, reqId, response)
+}
+
+// @Tags Translation
+// @Id getLanguages
+// @Summary Get languages with numbers of translated and confirmed words and coverage of the word list
+// @Success 200 {object} types.GetLanguagesResponse
+// @Failure 500 {object} types.ErrorResponse
+// @Router /languages [get]
+func (s *Server) languages(w http.ResponseWriter, r *http.Request) {
+	reqId, _ := r.Context().Value("reqId").(int)
+	response, err := s.engine.GetLanguages(r.Context())
+	if err != nil {
+		writeEngineErrResponse(w, r, reqId, err)
+		return
+	}
+	writeResponse(w, reqId, response)
+}
+
+// @Tags Translation
+// @Id addLanguage
+// @Summary Add language or enable disabled one
+// @Param api-key header string true "admin api key"
+// @Param language body types.AddLanguageRequest true "BCP-47 language tag"
+// @Success 200 {object} types.Language
+// @Failure 400 {object} types.ErrorResponse
+// @Failure 403 {object} types.ErrorResponse
+// @Failure 500 {object} types.ErrorResponse
+// @Router /languages [post]
+func (s *Server) addLanguage(w http.ResponseWriter, r *http.Request) {
+	reqId, _ := r.Context().Value("reqId").(int)
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeErrResponse(w, reqId, http.StatusInternalServerError, err.Error())
+		return
+	}
+	request := types.AddLanguageRequest{}
+	if err := json.Unmarshal(body, &request); err != nil {
+		writeErrResponse(w, reqId, http.StatusBadRequest, err.Error())
+		return
+	}
+	response, err := s.engine.AddLanguage(r.Context(), request)
+	if err != nil {
+		writeEngineErrResponse(w, r, reqId, err)
+		return
+	}
+	writeResponse(w, reqId, response)
+}
+
+// @Tags Translation
+// @Id disableLanguage
+// @Summary Disable language, translations of disabled language can be read but not submitted
+// @Param api-key header string true "admin api key"
+// @Param language path string true "language"
+// @Success 200 {object} types.Language
+// @Failure 400 {object} types.ErrorResponse
+// @Failure 403 {object} types.ErrorResponse
+// @Failure 500 {object} types.ErrorResponse
+// @Router /languages/{language}/disable [post]
+func (s *Server) disableLanguage(w http.ResponseWriter, r *http.Request) {
+	reqId, _ := r.Context().Value("reqId").(int)
+	response, err := s.engine.DisableLanguage(r.Context(), mux.Vars(r)["language"])
+	if err != nil {
+		writeEngineErrResponse(w, r, reqId, err)
+		return
+	}
+	writeResponse(w, reqId, response)
+}
+
+// @Tags Translation
+// @Id exportConfirmedTranslations
+// @Summary Export confirmed translations of all words of the language
+// @Param language path string true "language"
+// @Param format query string false "export format" Enums(json, csv, po) default(json)
+// @Produce json
+// @Produce text/csv
+// @Produce text/x-gettext-translation
+// @Success 200 {string} string "confirmed translations in requested format"
+// @Failure 400 {object} types.ErrorResponse
+// @Failure 500 {object} types.ErrorResponse
+// @Router /language/{language}/confirmed-translations [get]
+func (s *Server) exportConfirmedTranslations(w http.ResponseWriter, r *http.Request) {
+	reqId, _ := r.Context().Value("reqId").(int)
+	format := r.Form.Get("format")
+	if len(format) == 0 {
+		format = export.JsonFormat
+	}
+	contentType, ok := export.ContentType(format)
+	if !ok {
+		writeErrResponse(w, reqId, http.StatusBadRequest, "invalid value 'format'")
+		return
+	}
+	language := mux.Vars(r)["language"]
+	exportWriter := &lazyHeaderWriter{
+		ResponseWriter: w,
+		headers: map[string]string{
+			"Content-Type":        contentType,
+			"Content-Disposition": fmt.Sprintf("attachment; filename=%q", language+"."+format),
+		},
+	}
+	err := s.engine.ExportConfirmedTranslations(r.Context(), language, format, exportWriter)
+	if err == nil {
+		return
+	}
+	if exportWriter.started {
+		log.Error(fmt.Sprintf("Unable to complete export for request %v: %v", reqId, err))
+		return
+	}
+	writeEngineErrResponse(w, r, reqId, err)
+}
+
+const maxImportBodySize = 10 << 20
+
+// @Tags Translation
+// @Id importTranslations
+// @Summary Import translations from csv or json file, rows conflicting with existing translations are skipped
+// @Param api-key header string true "admin api key"
+// @Param source query string true "provenance marker stored with imported translations"
+// @Param format query string false "file format" Enums(json, csv) default(json)
+// @Param language query string false "language of rows without language"
+// @Param dry-run query boolean false "only report conflicts and errors without importing"
+// @Param file body string true "json array of rows or csv with header: word,name,description[,language][,address][,upVotes]"
+// @Accept json
+// @Accept text/csv
+// @Success 200 {object} types.ImportTranslationsResponse
+// @Failure 400 {object} types.ErrorResponse
+// @Failure 403 {object} types.ErrorResponse
+// @Failure 500 {object} types.ErrorResponse
+// @Router /translations/import [post]
+func (s *Server) importTranslations(w http.ResponseWriter, r *http.Request) {
+	reqId, _ := r.Context().Value("reqId").(int)
+	format := r.Form.Get("format")
+	if len(format) == 0 {
+		format = export.JsonFormat
+	}
+	dryRun, _ := strconv.ParseBool(r.Form.Get("dry-run"))
+	rows, err := importer.ReadRows(format, http.MaxBytesReader(w, r.Body, maxImportBodySize), r.Form.Get("language"))
+	if err != nil {
+		writeErrResponse(w, reqId, http.StatusBadRequest, err.Error())
+		return
+	}
+	response, err := s.engine.ImportTranslations(r.Context(), rows, r.Form.Get("source"), dryRun)
+	if err != nil {
+		writeEngineErrResponse(w, r, reqId, err)
+		return
+	}
+	writeResponse(w, reqId, response)
+}
+
+// @Tags Translation
+// @Id getNodeStatus
+// @Summary Get health and circuit breaker state of nodes
+// @Param api-key header string true "admin api key"
+// @Success 200 {object} types.GetNodeStatusResponse
+// @Failure 403 {object} types.ErrorResponse
+// @Failure 500 {object} types.ErrorResponse
+// @Router /node/status [get]
+func (s *Server) nodeStatus(w http.ResponseWriter, r *http.Request) {
+	reqId, _ := r.Context().Value("reqId").(int)
+	response, err := s.engine.GetNodeStatus(r.Context())
+	if err != nil {
+		writeEngineErrResponse(w, r, reqId, err)
+		return
+	}
+	writeResponse(w, reqId, response)
+}
+
+// @Tags Translation
+// @Id report
+// @Summary Report abusive translation, the translation is hidden pending review after enough reports
+// @Param report body types.ReportRequest true "report details"
+// @Success 200 {object} types.ReportResponse
+// @Header 200 {integer} Retry-After "seconds after which the rate limited request can be repeated"
+// @Failure 400 {object} types.ErrorResponse
+// @Failure 500 {object} types.ErrorResponse
+// @Router /report [post]
+func (s *Server) report(w http.ResponseWriter, r *http.Request) {
+	reqId, _ := r.Context().Value("reqId").(int)
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeErrResponse(w, reqId, http.StatusInternalServerError, err.Error())
+		return
+	}
+	request := types.ReportRequest{}
+	if err := json.Unmarshal(body, &request); err != nil {
+		writeErrResponse(w, reqId, http.StatusBadRequest, err.Error())
+		return
+	}
+	response, err := s.engine.Report(r.Context(), request)
+	if err != nil {
+		writeEngineErrResponse(w, r, reqId, err)
+		return
+	}
+	setRetryAfter(w, response.ResCode, response.RetryAfter)
+	writeResponse(w, reqId, response)
+}
+
+// @Tags Translation
+// @Id getModerationQueue
+// @Summary Get translations hidden by reports pending review
+// @Param api-key header string true "moderator or admin api key"
+// @Success 200 {object} types.GetModerationQueueResponse
+// @Failure 403 {object} types.ErrorResponse
+// @Failure 500 {object} types.ErrorResponse
+// @Router /moderation/translations [get]
+func (s *Server) moderationQueue(w http.ResponseWriter, r *http.Request) {
+	reqId, _ := r.Context().Value("reqId").(int)
+	response, err := s.engine.GetModerationQueue(r.Context())
+	if err != nil {
+		writeEngineErrResponse(w, r, reqId, err)
+		return
+	}
+	writeResponse(w, reqId, response)
+}
+
+// @Tags Translation
+// @Id restoreTranslation
+// @Summary Make reported translation visible and resolve its reports
+// @Param api-key header string true "moderator or admin api key"
+// @Param id path string true "translation id"
+// @Success 200 {object} types.ModeratedTranslation
+// @Failure 400 {object} types.ErrorResponse
+// @Failure 403 {object} types.ErrorResponse
+// @Failure 500 {object} types.ErrorResponse
+// @Router /translation/{id}/restore [post]
+func (s *Server) restoreTranslation(w http.ResponseWriter, r *http.Request) {
+	reqId, _ := r.Context().Value("reqId").(int)
+	response, err := s.engine.RestoreTranslation(r.Context(), mux.Vars(r)["id"])
+	if err != nil {
+		writeEngineErrResponse(w, r, reqId, err)
+		return
+	}
+	writeResponse(w, reqId, response)
+}
+
+// @Tags Translation
+// @Id removeTranslation
+// @Summary Remove reported translation permanently and resolve its reports
+// @Param api-key header string true "moderator or admin api key"
+// @Param id path string true "translation id"
+// @Success 200 {object} types.ModeratedTranslation
+// @Failure 400 {object} types.ErrorResponse
+// @Failure 403 {object} types.ErrorResponse
+// @Failure 500 {object} types.ErrorResponse
+// @Router /translation/{id}/remove [post]
+func (s *Server) removeTranslation(w http.ResponseWriter, r *http.Request) {
+	reqId, _ := r.Context().Value("reqId").(int)
+	response, err := s.engine.RemoveTranslation(r.Context(), mux.Vars(r)["id"])
+	if err != nil {
+		writeEngineErrResponse(w, r, reqId, err)
+		return
+	}
+	writeResponse(w, reqId, response)
+}
+
+// lazyHeaderWriter sets headers right before the first write so that an error response can still be sent
+// if nothing has been written yet
+type lazyHeaderWriter struct {
+	http.ResponseWriter
+	headers map[string]string
+	started bool
+}
+
+func (w *lazyHeaderWriter) Write(p []byte) (int, error) {
+	if !w.started {
+		for key, value := range w.headers {
+			w.Header().Set(key, value)
+		}
+		w.started = true
+	}
+	return w.ResponseWriter.Write(p)
+}
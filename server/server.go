@@ -2,33 +2,55 @@ package server
 
 import (
 	"context"
+	"crypto/subtle"
 	"encoding/json"
 	"fmt"
 	"github.com/gorilla/handlers"
 	"github.com/gorilla/mux"
 	"github.com/idena-network/idena-translation/config"
 	"github.com/idena-network/idena-translation/core"
+	"github.com/idena-network/idena-translation/core/ratelimit"
 	"github.com/idena-network/idena-translation/docs"
 	"github.com/idena-network/idena-translation/types"
 	log "github.com/inconshreveable/log15"
 	httpSwagger "github.com/swaggo/http-swagger"
 	"net/http"
+	"strconv"
 	"strings"
 	"sync"
+	"time"
 )
 
 type Server struct {
-	port       int
-	engine     core.Engine
-	mutex      sync.Mutex
-	counter    int
-	httpServer *http.Server
+	port            int
+	adminApiKey     string
+	moderatorApiKey string
+	requestTimeout  time.Duration
+	routeTimeouts   map[string]time.Duration
+	trustedProxies  TrustedProxies
+	engine          core.Engine
+	mutex           sync.Mutex
+	counter         int
+	httpServer      *http.Server
 }
 
-func NewServer(port int, engine core.Engine) *Server {
+func NewServer(serverConfig config.ServerConfig, engine core.Engine) *Server {
+	routeTimeouts := make(map[string]time.Duration, len(serverConfig.RouteTimeoutsSec))
+	for route, timeoutSec := range serverConfig.RouteTimeoutsSec {
+		routeTimeouts[route] = time.Second * time.Duration(timeoutSec)
+	}
+	trustedProxies, err := ParseTrustedProxies(serverConfig.TrustedProxies)
+	if err != nil {
+		panic(err)
+	}
 	return &Server{
-		port:   port,
-		engine: engine,
+		port:            serverConfig.Port,
+		adminApiKey:     serverConfig.AdminApiKey,
+		moderatorApiKey: serverConfig.ModeratorApiKey,
+		requestTimeout:  time.Second * time.Duration(serverConfig.RequestTimeoutSec),
+		routeTimeouts:   routeTimeouts,
+		trustedProxies:  trustedProxies,
+		engine:          engine,
 	}
 }
 
@@ -44,11 +66,12 @@ func (s *Server) Start(swaggerConfig config.SwaggerConfig) {
 			httpSwagger.URL("doc.json"),
 		))
 	}
-	headersOk := handlers.AllowedHeaders([]string{"X-Requested-With", "Content-Type"})
+	headersOk := handlers.AllowedHeaders([]string{"X-Requested-With", "Content-Type", "api-key", "continuation-token"})
+	exposedHeadersOk := handlers.ExposedHeaders([]string{"continuation-token", "prev-continuation-token", "Retry-After"})
 	originsOk := handlers.AllowedOrigins([]string{"*"})
 	methodsOk := handlers.AllowedMethods([]string{"GET", "HEAD", "POST", "PUT", "OPTIONS"})
 	addr := fmt.Sprintf(":%d", s.port)
-	handler := handlers.CORS(originsOk, headersOk, methodsOk)(s.requestFilter(router))
+	handler := handlers.CORS(originsOk, headersOk, exposedHeadersOk, methodsOk)(s.requestFilter(router))
 	httpServer := &http.Server{Addr: addr, Handler: handler}
 	s.httpServer = httpServer
 	log.Info(fmt.Sprintf("Server started at port %v", s.port))
@@ -70,7 +93,8 @@ func (s *Server) requestFilter(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if !strings.HasPrefix(strings.ToLower(r.URL.Path), "/swagger") {
 			reqId := s.generateReqId()
-			log.Debug(fmt.Sprintf("Got request %v, url: %v, from: %v", reqId, r.URL, GetIP(r)))
+			ip := s.trustedProxies.ClientIp(r)
+			log.Debug(fmt.Sprintf("Got request %v, url: %v, from: %v", reqId, r.URL, ip))
 			defer log.Debug(fmt.Sprintf("Completed request %v", reqId))
 			err := r.ParseForm()
 			if err != nil {
@@ -80,6 +104,7 @@ func (s *Server) requestFilter(next http.Handler) http.Handler {
 			r.URL.Path = strings.ToLower(r.URL.Path)
 			ctx := r.Context()
 			ctx = context.WithValue(ctx, "reqId", reqId)
+			ctx = ratelimit.WithIp(ctx, ip)
 			r = r.WithContext(ctx)
 		}
 		next.ServeHTTP(w, r)
@@ -94,23 +119,85 @@ func (s *Server) generateReqId() int {
 	return id
 }
 
-func GetIP(r *http.Request) string {
-	header := r.Header.Get("X-Forwarded-For")
-	if len(header) > 0 {
-		return strings.Split(header, ", ")[0]
+func (s *Server) initRouter(router *mux.Router) {
+	router.Path(strings.ToLower("/translation")).
+		HandlerFunc(s.withTimeout("submitTranslation", s.submitTranslation)).Methods("POST")
+	router.Path(strings.ToLower("/word/{word:[0-9]+}/language/{language}/translations")).
+		HandlerFunc(s.withTimeout("getTranslations", s.getTranslations)).Methods("GET")
+	router.Path(strings.ToLower("/vote")).
+		HandlerFunc(s.withTimeout("vote", s.vote)).Methods("POST")
+	router.Path(strings.ToLower("/vote/retract")).
+		HandlerFunc(s.withTimeout("retractVote", s.retractVote)).Methods("POST")
+	router.Path(strings.ToLower("/word/{word:[0-9]+}/language/{language}/confirmed-translation")).
+		HandlerFunc(s.withTimeout("getConfirmedTranslation", s.confirmedTranslation)).Methods("GET")
+	router.Path(strings.ToLower("/confirmed-translations")).
+		HandlerFunc(s.withTimeout("getConfirmedTranslationsByWords", s.confirmedTranslationsByWords)).Methods("POST")
+	router.Path(strings.ToLower("/translation/{id}/history")).
+		HandlerFunc(s.withTimeout("getTranslationHistory", s.translationHistory)).Methods("GET")
+	router.Path(strings.ToLower("/address/{address}/translations")).
+		HandlerFunc(s.withTimeout("getAddressTranslations", s.addressTranslations)).Methods("GET")
+	router.Path(strings.ToLower("/address/{address}/votes")).
+		HandlerFunc(s.withTimeout("getAddressVotes", s.addressVotes)).Methods("GET")
+	router.Path(strings.ToLower("/languages")).
+		HandlerFunc(s.withTimeout("getLanguages", s.languages)).Methods("GET")
+	router.Path(strings.ToLower("/language/{language}/confirmed-translations")).
+		HandlerFunc(s.withTimeout("exportConfirmedTranslations", s.exportConfirmedTranslations)).Methods("GET")
+	router.Path(strings.ToLower("/languages")).
+		HandlerFunc(s.withTimeout("addLanguage", s.adminOnly(s.addLanguage))).Methods("POST")
+	router.Path(strings.ToLower("/languages/{language}/disable")).
+		HandlerFunc(s.withTimeout("disableLanguage", s.adminOnly(s.disableLanguage))).Methods("POST")
+	router.Path(strings.ToLower("/translations/import")).
+		HandlerFunc(s.withTimeout("importTranslations", s.adminOnly(s.importTranslations))).Methods("POST")
+	router.Path(strings.ToLower("/node/status")).
+		HandlerFunc(s.withTimeout("getNodeStatus", s.adminOnly(s.nodeStatus))).Methods("GET")
+	router.Path(strings.ToLower("/report")).
+		HandlerFunc(s.withTimeout("report", s.report)).Methods("POST")
+	router.Path(strings.ToLower("/moderation/translations")).
+		HandlerFunc(s.withTimeout("getModerationQueue", s.moderatorOnly(s.moderationQueue))).Methods("GET")
+	router.Path(strings.ToLower("/translation/{id}/restore")).
+		HandlerFunc(s.withTimeout("restoreTranslation", s.moderatorOnly(s.restoreTranslation))).Methods("POST")
+	router.Path(strings.ToLower("/translation/{id}/remove")).
+		HandlerFunc(s.withTimeout("removeTranslation", s.moderatorOnly(s.removeTranslation))).Methods("POST")
+}
+
+// withTimeout sets the deadline of the request context, the route is the swagger operation id of the handler
+func (s *Server) withTimeout(route string, next http.HandlerFunc) http.HandlerFunc {
+	timeout, ok := s.routeTimeouts[route]
+	if !ok {
+		timeout = s.requestTimeout
+	}
+	if timeout <= 0 {
+		return next
 	}
-	if strings.Contains(r.RemoteAddr, ":") {
-		return strings.Split(r.RemoteAddr, ":")[0]
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), timeout)
+		defer cancel()
+		next(w, r.WithContext(ctx))
 	}
-	return r.RemoteAddr
 }
 
-func (s *Server) initRouter(router *mux.Router) {
-	router.Path(strings.ToLower("/translation")).HandlerFunc(s.submitTranslation).Methods("POST")
-	router.Path(strings.ToLower("/word/{word:[0-9]+}/language/{language}/translations")).
-		HandlerFunc(s.getTranslations).Methods("GET")
-	router.Path(strings.ToLower("/vote")).HandlerFunc(s.vote).Methods("POST")
-	router.Path(strings.ToLower("/word/{word:[0-9]+}/language/{language}/confirmed-translation")).HandlerFunc(s.confirmedTranslation).Methods("GET")
+func (s *Server) adminOnly(next http.HandlerFunc) http.HandlerFunc {
+	return withApiKey(next, s.adminApiKey)
+}
+
+// moderatorOnly accepts both moderator and admin keys, so admins can moderate without a separate key
+func (s *Server) moderatorOnly(next http.HandlerFunc) http.HandlerFunc {
+	return withApiKey(next, s.moderatorApiKey, s.adminApiKey)
+}
+
+// withApiKey passes the request if its 'api-key' header matches one of not empty keys
+func withApiKey(next http.HandlerFunc, keys ...string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		apiKey := r.Header.Get("api-key")
+		for _, key := range keys {
+			if len(key) > 0 && subtle.ConstantTimeCompare([]byte(apiKey), []byte(key)) == 1 {
+				next(w, r)
+				return
+			}
+		}
+		reqId, _ := r.Context().Value("reqId").(int)
+		writeErrResponse(w, reqId, http.StatusForbidden, "forbidden")
+	}
 }
 
 func writeErrResponse(w http.ResponseWriter, reqId int, code int, errMessage string) {
@@ -122,6 +209,30 @@ func writeErrResponse(w http.ResponseWriter, reqId int, code int, errMessage str
 	})
 }
 
+// writeEngineErrResponse writes the error returned by the engine, the status depends on the error type and
+// on the state of the request context
+func writeEngineErrResponse(w http.ResponseWriter, r *http.Request, reqId int, err error) {
+	if _, ok := err.(*types.BadRequestError); ok {
+		writeErrResponse(w, reqId, http.StatusBadRequest, err.Error())
+		return
+	}
+	switch r.Context().Err() {
+	case context.DeadlineExceeded:
+		writeErrResponse(w, reqId, http.StatusServiceUnavailable, "request timed out")
+	case context.Canceled:
+		log.Debug(fmt.Sprintf("Request %v is canceled: %v", reqId, err))
+	default:
+		writeErrResponse(w, reqId, http.StatusInternalServerError, err.Error())
+	}
+}
+
+// setRetryAfter sets Retry-After header of the rate limited request
+func setRetryAfter(w http.ResponseWriter, resCode byte, retryAfter int) {
+	if resCode == types.RateLimitedError.Code() && retryAfter > 0 {
+		w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+	}
+}
+
 func writeResponse(w http.ResponseWriter, reqId int, body interface{}) {
 	w.Header().Set("Content-Type", "application/json")
 	writeResponseBody(w, reqId, body)
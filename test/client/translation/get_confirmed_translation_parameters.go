@@ -61,6 +61,11 @@ for the get confirmed translation operation typically these are written to a htt
 */
 type GetConfirmedTranslationParams struct {
 
+	/*Address
+	  address to return its vote and translation
+
+	*/
+	Address *string
 	/*Language
 	  language
 
@@ -110,6 +115,17 @@ func (o *GetConfirmedTranslationParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithAddress adds the address to the get confirmed translation params
+func (o *GetConfirmedTranslationParams) WithAddress(address *string) *GetConfirmedTranslationParams {
+	o.SetAddress(address)
+	return o
+}
+
+// SetAddress adds the address to the get confirmed translation params
+func (o *GetConfirmedTranslationParams) SetAddress(address *string) {
+	o.Address = address
+}
+
 // WithLanguage adds the language to the get confirmed translation params
 func (o *GetConfirmedTranslationParams) WithLanguage(language string) *GetConfirmedTranslationParams {
 	o.SetLanguage(language)
@@ -140,6 +156,22 @@ func (o *GetConfirmedTranslationParams) WriteToRequest(r runtime.ClientRequest,
 	}
 	var res []error
 
+	if o.Address != nil {
+
+		// query param address
+		var qrAddress string
+		if o.Address != nil {
+			qrAddress = *o.Address
+		}
+		qAddress := qrAddress
+		if qAddress != "" {
+			if err := r.SetQueryParam("address", qAddress); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	// path param language
 	if err := r.SetPathParam("language", o.Language); err != nil {
 		return err
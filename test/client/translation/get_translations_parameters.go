@@ -61,8 +61,13 @@ for the get translations operation typically these are written to a http.Request
 */
 type GetTranslationsParams struct {
 
+	/*Address
+	  address to return its votes and translations
+
+	*/
+	Address *string
 	/*ContinuationToken
-	  continuation token to get next translations
+	  continuation token to get next or previous translations
 
 	*/
 	ContinuationToken *string
@@ -71,6 +76,11 @@ type GetTranslationsParams struct {
 
 	*/
 	Language string
+	/*Total
+	  return total number of translations
+
+	*/
+	Total *bool
 	/*Word
 	  word id
 
@@ -115,6 +125,17 @@ func (o *GetTranslationsParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithAddress adds the address to the get translations params
+func (o *GetTranslationsParams) WithAddress(address *string) *GetTranslationsParams {
+	o.SetAddress(address)
+	return o
+}
+
+// SetAddress adds the address to the get translations params
+func (o *GetTranslationsParams) SetAddress(address *string) {
+	o.Address = address
+}
+
 // WithContinuationToken adds the continuationToken to the get translations params
 func (o *GetTranslationsParams) WithContinuationToken(continuationToken *string) *GetTranslationsParams {
 	o.SetContinuationToken(continuationToken)
@@ -137,6 +158,17 @@ func (o *GetTranslationsParams) SetLanguage(language string) {
 	o.Language = language
 }
 
+// WithTotal adds the total to the get translations params
+func (o *GetTranslationsParams) WithTotal(total *bool) *GetTranslationsParams {
+	o.SetTotal(total)
+	return o
+}
+
+// SetTotal adds the total to the get translations params
+func (o *GetTranslationsParams) SetTotal(total *bool) {
+	o.Total = total
+}
+
 // WithWord adds the word to the get translations params
 func (o *GetTranslationsParams) WithWord(word int64) *GetTranslationsParams {
 	o.SetWord(word)
@@ -156,6 +188,22 @@ func (o *GetTranslationsParams) WriteToRequest(r runtime.ClientRequest, reg strf
 	}
 	var res []error
 
+	if o.Address != nil {
+
+		// query param address
+		var qrAddress string
+		if o.Address != nil {
+			qrAddress = *o.Address
+		}
+		qAddress := qrAddress
+		if qAddress != "" {
+			if err := r.SetQueryParam("address", qAddress); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	if o.ContinuationToken != nil {
 
 		// header param continuation-token
@@ -170,6 +218,22 @@ func (o *GetTranslationsParams) WriteToRequest(r runtime.ClientRequest, reg strf
 		return err
 	}
 
+	if o.Total != nil {
+
+		// query param total
+		var qTotal bool
+		if o.Total != nil {
+			qTotal = *o.Total
+		}
+		qTotalStr := swag.FormatBool(qTotal)
+		if qTotalStr != "" {
+			if err := r.SetQueryParam("total", qTotalStr); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	// path param word
 	if err := r.SetPathParam("word", swag.FormatInt64(o.Word)); err != nil {
 		return err
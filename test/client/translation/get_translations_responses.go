@@ -57,10 +57,14 @@ func NewGetTranslationsOK() *GetTranslationsOK {
 OK
 */
 type GetTranslationsOK struct {
-	/*continuation token
+	/*continuation token of the next page
 	 */
 	ContinuationToken string
 
+	/*continuation token of the previous page
+	 */
+	PrevContinuationToken string
+
 	Payload *models.GetTranslationsResponse
 }
 
@@ -77,6 +81,9 @@ func (o *GetTranslationsOK) readResponse(response runtime.ClientResponse, consum
 	// response header continuation-token
 	o.ContinuationToken = response.GetHeader("continuation-token")
 
+	// response header prev-continuation-token
+	o.PrevContinuationToken = response.GetHeader("prev-continuation-token")
+
 	o.Payload = new(models.GetTranslationsResponse)
 
 	// response payload
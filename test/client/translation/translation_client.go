@@ -27,10 +27,36 @@ type Client struct {
 
 // ClientService is the interface for Client methods
 type ClientService interface {
+	AddLanguage(params *AddLanguageParams) (*AddLanguageOK, error)
+
+	DisableLanguage(params *DisableLanguageParams) (*DisableLanguageOK, error)
+
+	GetAddressTranslations(params *GetAddressTranslationsParams) (*GetAddressTranslationsOK, error)
+
+	GetAddressVotes(params *GetAddressVotesParams) (*GetAddressVotesOK, error)
+
 	GetConfirmedTranslation(params *GetConfirmedTranslationParams) (*GetConfirmedTranslationOK, error)
 
+	GetConfirmedTranslationsByWords(params *GetConfirmedTranslationsByWordsParams) (*GetConfirmedTranslationsByWordsOK, error)
+
+	GetLanguages(params *GetLanguagesParams) (*GetLanguagesOK, error)
+
+	GetModerationQueue(params *GetModerationQueueParams) (*GetModerationQueueOK, error)
+
+	GetNodeStatus(params *GetNodeStatusParams) (*GetNodeStatusOK, error)
+
+	GetTranslationHistory(params *GetTranslationHistoryParams) (*GetTranslationHistoryOK, error)
+
 	GetTranslations(params *GetTranslationsParams) (*GetTranslationsOK, error)
 
+	RemoveTranslation(params *RemoveTranslationParams) (*RemoveTranslationOK, error)
+
+	Report(params *ReportParams) (*ReportOK, error)
+
+	RestoreTranslation(params *RestoreTranslationParams) (*RestoreTranslationOK, error)
+
+	RetractVote(params *RetractVoteParams) (*RetractVoteOK, error)
+
 	SubmitTranslation(params *SubmitTranslationParams) (*SubmitTranslationOK, error)
 
 	Vote(params *VoteParams) (*VoteOK, error)
@@ -38,6 +64,142 @@ type ClientService interface {
 	SetTransport(transport runtime.ClientTransport)
 }
 
+/*
+  AddLanguage adds language or enable disabled one
+*/
+func (a *Client) AddLanguage(params *AddLanguageParams) (*AddLanguageOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewAddLanguageParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "addLanguage",
+		Method:             "POST",
+		PathPattern:        "/languages",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &AddLanguageReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*AddLanguageOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for addLanguage: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  DisableLanguage disables language, translations of disabled language can be read but not submitted
+*/
+func (a *Client) DisableLanguage(params *DisableLanguageParams) (*DisableLanguageOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDisableLanguageParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "disableLanguage",
+		Method:             "POST",
+		PathPattern:        "/languages/{language}/disable",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &DisableLanguageReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*DisableLanguageOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for disableLanguage: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  GetAddressTranslations Get translations submitted by the address, the latest first
+*/
+func (a *Client) GetAddressTranslations(params *GetAddressTranslationsParams) (*GetAddressTranslationsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetAddressTranslationsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "getAddressTranslations",
+		Method:             "GET",
+		PathPattern:        "/address/{address}/translations",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetAddressTranslationsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetAddressTranslationsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for getAddressTranslations: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  GetAddressVotes Get votes of the address with voted translations, votes for the latest translations first
+*/
+func (a *Client) GetAddressVotes(params *GetAddressVotesParams) (*GetAddressVotesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetAddressVotesParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "getAddressVotes",
+		Method:             "GET",
+		PathPattern:        "/address/{address}/votes",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetAddressVotesReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetAddressVotesOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for getAddressVotes: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
   GetConfirmedTranslation gets confirmed translation
 */
@@ -72,6 +234,176 @@ func (a *Client) GetConfirmedTranslation(params *GetConfirmedTranslationParams)
 	panic(msg)
 }
 
+/*
+  GetConfirmedTranslationsByWords Get confirmed translations of many words in one or more languages
+*/
+func (a *Client) GetConfirmedTranslationsByWords(params *GetConfirmedTranslationsByWordsParams) (*GetConfirmedTranslationsByWordsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetConfirmedTranslationsByWordsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "getConfirmedTranslationsByWords",
+		Method:             "POST",
+		PathPattern:        "/confirmed-translations",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetConfirmedTranslationsByWordsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetConfirmedTranslationsByWordsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for getConfirmedTranslationsByWords: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  GetLanguages gets languages available for translation
+*/
+func (a *Client) GetLanguages(params *GetLanguagesParams) (*GetLanguagesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetLanguagesParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "getLanguages",
+		Method:             "GET",
+		PathPattern:        "/languages",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetLanguagesReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetLanguagesOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for getLanguages: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  GetModerationQueue Get translations hidden by reports pending review
+*/
+func (a *Client) GetModerationQueue(params *GetModerationQueueParams) (*GetModerationQueueOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetModerationQueueParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "getModerationQueue",
+		Method:             "GET",
+		PathPattern:        "/moderation/translations",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetModerationQueueReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetModerationQueueOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for getModerationQueue: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  GetNodeStatus Get health and circuit breaker state of nodes
+*/
+func (a *Client) GetNodeStatus(params *GetNodeStatusParams) (*GetNodeStatusOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetNodeStatusParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "getNodeStatus",
+		Method:             "GET",
+		PathPattern:        "/node/status",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetNodeStatusReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetNodeStatusOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for getNodeStatus: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  GetTranslationHistory gets earlier versions of translation replaced by resubmissions
+*/
+func (a *Client) GetTranslationHistory(params *GetTranslationHistoryParams) (*GetTranslationHistoryOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetTranslationHistoryParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "getTranslationHistory",
+		Method:             "GET",
+		PathPattern:        "/translation/{id}/history",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetTranslationHistoryReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetTranslationHistoryOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for getTranslationHistory: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
   GetTranslations gets translations sorted by rating
 */
@@ -106,6 +438,142 @@ func (a *Client) GetTranslations(params *GetTranslationsParams) (*GetTranslation
 	panic(msg)
 }
 
+/*
+  RemoveTranslation Remove reported translation permanently and resolve its reports
+*/
+func (a *Client) RemoveTranslation(params *RemoveTranslationParams) (*RemoveTranslationOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRemoveTranslationParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "removeTranslation",
+		Method:             "POST",
+		PathPattern:        "/translation/{id}/remove",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &RemoveTranslationReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*RemoveTranslationOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for removeTranslation: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  Report Report abusive translation, the translation is hidden pending review after enough reports
+*/
+func (a *Client) Report(params *ReportParams) (*ReportOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewReportParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "report",
+		Method:             "POST",
+		PathPattern:        "/report",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ReportReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ReportOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for report: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  RestoreTranslation Make reported translation visible and resolve its reports
+*/
+func (a *Client) RestoreTranslation(params *RestoreTranslationParams) (*RestoreTranslationOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRestoreTranslationParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "restoreTranslation",
+		Method:             "POST",
+		PathPattern:        "/translation/{id}/restore",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &RestoreTranslationReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*RestoreTranslationOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for restoreTranslation: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  RetractVote Retract vote for or against translation
+*/
+func (a *Client) RetractVote(params *RetractVoteParams) (*RetractVoteOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRetractVoteParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "retractVote",
+		Method:             "POST",
+		PathPattern:        "/vote/retract",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &RetractVoteReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*RetractVoteOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for retractVote: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
   SubmitTranslation creates or update translation
 */
@@ -9,8 +9,10 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 
 	"github.com/idena-network/idena-translation/test/models"
 )
@@ -57,6 +59,10 @@ func NewVoteOK() *VoteOK {
 OK
 */
 type VoteOK struct {
+	/*seconds after which the rate limited request can be repeated
+	 */
+	RetryAfter int64
+
 	Payload *models.VoteResponse
 }
 
@@ -70,6 +76,17 @@ func (o *VoteOK) GetPayload() *models.VoteResponse {
 
 func (o *VoteOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// response header Retry-After
+	hdrRetryAfter := response.GetHeader("Retry-After")
+
+	if hdrRetryAfter != "" {
+		valretryAfter, err := swag.ConvertInt64(hdrRetryAfter)
+		if err != nil {
+			return errors.InvalidType("Retry-After", "header", "int64", hdrRetryAfter)
+		}
+		o.RetryAfter = valretryAfter
+	}
+
 	o.Payload = new(models.VoteResponse)
 
 	// response payload
@@ -18,6 +18,9 @@ import (
 // swagger:model GetTranslationsResponse
 type GetTranslationsResponse struct {
 
+	// Total is the number of translations of the word, it is returned on request only
+	Total int64 `json:"total,omitempty"`
+
 	// translations
 	Translations []*Translation `json:"translations"`
 }
@@ -6,6 +6,8 @@ package models
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"encoding/json"
+
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
@@ -34,6 +36,10 @@ type SubmitTranslationRequest struct {
 	// timestamp
 	Timestamp string `json:"timestamp,omitempty"`
 
+	// Version is the format of the signed value, requests without version are signed in the legacy format
+	// Enum: [1 2]
+	Version int64 `json:"version,omitempty"`
+
 	// word
 	// Maximum: 4615
 	Word int64 `json:"word,omitempty"`
@@ -51,6 +57,10 @@ func (m *SubmitTranslationRequest) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateVersion(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateWord(formats); err != nil {
 		res = append(res, err)
 	}
@@ -87,6 +97,40 @@ func (m *SubmitTranslationRequest) validateName(formats strfmt.Registry) error {
 	return nil
 }
 
+var submitTranslationRequestTypeVersionPropEnum []interface{}
+
+func init() {
+	var res []int64
+	if err := json.Unmarshal([]byte(`[1,2]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		submitTranslationRequestTypeVersionPropEnum = append(submitTranslationRequestTypeVersionPropEnum, v)
+	}
+}
+
+// prop value enum
+func (m *SubmitTranslationRequest) validateVersionEnum(path, location string, value int64) error {
+	if err := validate.Enum(path, location, value, submitTranslationRequestTypeVersionPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *SubmitTranslationRequest) validateVersion(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Version) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateVersionEnum("version", "body", m.Version); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *SubmitTranslationRequest) validateWord(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.Word) { // not required
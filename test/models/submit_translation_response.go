@@ -23,9 +23,12 @@ type SubmitTranslationResponse struct {
 	Error string `json:"error,omitempty"`
 
 	// res code
-	// Enum: [0 1 2 4]
+	// Enum: [0 1 2 4 6 7 8]
 	ResCode int64 `json:"resCode,omitempty"`
 
+	// RetryAfter is the number of seconds after which the rate limited request can be repeated
+	RetryAfter int64 `json:"retryAfter,omitempty"`
+
 	// translation Id
 	TranslationID string `json:"translationId,omitempty"`
 }
@@ -48,7 +51,7 @@ var submitTranslationResponseTypeResCodePropEnum []interface{}
 
 func init() {
 	var res []int64
-	if err := json.Unmarshal([]byte(`[0,1,2,4]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`[0,1,2,4,6,7,8]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -6,8 +6,12 @@ package models
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // Translation translation
@@ -27,15 +31,83 @@ type Translation struct {
 	// id
 	ID string `json:"id,omitempty"`
 
+	// IsMine is true if the translation is submitted by the address passed with the request
+	IsMine bool `json:"isMine,omitempty"`
+
+	// MyVote is the vote of the address passed with the request, it is only set if the address is passed
+	// Enum: [up down none]
+	MyVote string `json:"myVote,omitempty"`
+
 	// name
 	Name string `json:"name,omitempty"`
 
 	// up votes
 	UpVotes int64 `json:"upVotes,omitempty"`
+
+	// weighted down votes
+	WeightedDownVotes float64 `json:"weightedDownVotes,omitempty"`
+
+	// weighted up votes
+	WeightedUpVotes float64 `json:"weightedUpVotes,omitempty"`
 }
 
 // Validate validates this translation
 func (m *Translation) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateMyVote(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var translationTypeMyVotePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["up","down","none"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		translationTypeMyVotePropEnum = append(translationTypeMyVotePropEnum, v)
+	}
+}
+
+const (
+
+	// TranslationMyVoteUp captures enum value "up"
+	TranslationMyVoteUp string = "up"
+
+	// TranslationMyVoteDown captures enum value "down"
+	TranslationMyVoteDown string = "down"
+
+	// TranslationMyVoteNone captures enum value "none"
+	TranslationMyVoteNone string = "none"
+)
+
+// prop value enum
+func (m *Translation) validateMyVoteEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, translationTypeMyVotePropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Translation) validateMyVote(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.MyVote) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateMyVoteEnum("myVote", "body", m.MyVote); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -6,8 +6,12 @@ package models
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // VoteRequest vote request
@@ -26,10 +30,57 @@ type VoteRequest struct {
 
 	// up
 	Up bool `json:"up,omitempty"`
+
+	// Version is the format of the signed value, requests without version are signed in the legacy format
+	// Enum: [1 2]
+	Version int64 `json:"version,omitempty"`
 }
 
 // Validate validates this vote request
 func (m *VoteRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateVersion(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var voteRequestTypeVersionPropEnum []interface{}
+
+func init() {
+	var res []int64
+	if err := json.Unmarshal([]byte(`[1,2]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		voteRequestTypeVersionPropEnum = append(voteRequestTypeVersionPropEnum, v)
+	}
+}
+
+// prop value enum
+func (m *VoteRequest) validateVersionEnum(path, location string, value int64) error {
+	if err := validate.Enum(path, location, value, voteRequestTypeVersionPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *VoteRequest) validateVersion(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Version) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateVersionEnum("version", "body", m.Version); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -26,11 +26,20 @@ type VoteResponse struct {
 	Error string `json:"error,omitempty"`
 
 	// res code
-	// Enum: [0 3 4 5]
+	// Enum: [0 3 4 5 6 7 8]
 	ResCode int64 `json:"resCode,omitempty"`
 
+	// RetryAfter is the number of seconds after which the rate limited request can be repeated
+	RetryAfter int64 `json:"retryAfter,omitempty"`
+
 	// up votes
 	UpVotes int64 `json:"upVotes,omitempty"`
+
+	// weighted down votes
+	WeightedDownVotes float64 `json:"weightedDownVotes,omitempty"`
+
+	// weighted up votes
+	WeightedUpVotes float64 `json:"weightedUpVotes,omitempty"`
 }
 
 // Validate validates this vote response
@@ -51,7 +60,7 @@ var voteResponseTypeResCodePropEnum []interface{}
 
 func init() {
 	var res []int64
-	if err := json.Unmarshal([]byte(`[0,3,4,5]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`[0,3,4,5,6,7,8]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -3,19 +3,41 @@ package test
 import (
 	"context"
 	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
 	"fmt"
+	"github.com/go-openapi/runtime"
 	"github.com/idena-network/idena-translation/config"
 	"github.com/idena-network/idena-translation/core"
+	"github.com/idena-network/idena-translation/core/continuation"
+	"github.com/idena-network/idena-translation/core/ratelimit"
+	"github.com/idena-network/idena-translation/core/replay"
+	"github.com/idena-network/idena-translation/core/signing"
 	"github.com/idena-network/idena-translation/core/words_mapper"
+	"github.com/idena-network/idena-translation/crypto"
 	"github.com/idena-network/idena-translation/db"
+	"github.com/idena-network/idena-translation/db/memory"
 	"github.com/idena-network/idena-translation/db/postgres"
+	"github.com/idena-network/idena-translation/node"
 	"github.com/idena-network/idena-translation/server"
 	"github.com/idena-network/idena-translation/test/client"
 	"github.com/idena-network/idena-translation/test/client/translation"
 	"github.com/idena-network/idena-translation/test/models"
 	"github.com/idena-network/idena-translation/types"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 )
@@ -24,6 +46,8 @@ const (
 	port    = 10080
 	connStr = "postgres://postgres@localhost?sslmode=disable"
 	schema  = "translation_auto_test"
+
+	adminApiKey = "adminApiKey"
 )
 
 func Test_submitTranslation(t *testing.T) {
@@ -31,7 +55,7 @@ func Test_submitTranslation(t *testing.T) {
 	defer s.Stop()
 
 	address1 := "address1"
-	nodeClient.IdentitiesByAddr[address1] = true
+	nodeClient.IdentitiesByAddr[address1] = node.Identity{State: "Verified"}
 
 	// When
 	invalidName := ""
@@ -108,10 +132,10 @@ func Test_submitTranslation(t *testing.T) {
 	require.Equal(t, int64(types.OutdatedSubmissionError.Code()), res.GetPayload().ResCode)
 
 	// When
-	upVotes, downVotes, err := dbAccessor.Vote("address2", "1", true, time.Now())
+	counts, err := dbAccessor.Vote(context.Background(), "address2", "1", true, 1, time.Now())
 	require.Nil(t, err)
-	require.Equal(t, 1, upVotes)
-	require.Equal(t, 0, downVotes)
+	require.Equal(t, 1, counts.UpVotes)
+	require.Equal(t, 0, counts.DownVotes)
 	res, err = cl.Translation.SubmitTranslation(&translation.SubmitTranslationParams{
 		Translation: signedSubmitTransactionRequest(&models.SubmitTranslationRequest{
 			Word: 1, Language: "id", Name: "new name", Description: "description", Timestamp: "2020-01-01T09:30:00Z",
@@ -125,18 +149,18 @@ func Test_submitTranslation(t *testing.T) {
 	require.Empty(t, res.GetPayload().Error)
 
 	// When
-	upVotes, downVotes, err = dbAccessor.Vote("address2", "2", true, time.Now())
+	counts, err = dbAccessor.Vote(context.Background(), "address2", "2", true, 1, time.Now())
 	require.Nil(t, err)
-	require.Equal(t, 1, upVotes)
-	require.Equal(t, 0, downVotes)
-	upVotes, downVotes, err = dbAccessor.Vote("address3", "2", true, time.Now())
+	require.Equal(t, 1, counts.UpVotes)
+	require.Equal(t, 0, counts.DownVotes)
+	counts, err = dbAccessor.Vote(context.Background(), "address3", "2", true, 1, time.Now())
 	require.Nil(t, err)
-	require.Equal(t, 2, upVotes)
-	require.Equal(t, 0, downVotes)
-	upVotes, downVotes, err = dbAccessor.Vote("address4", "2", true, time.Now())
+	require.Equal(t, 2, counts.UpVotes)
+	require.Equal(t, 0, counts.DownVotes)
+	counts, err = dbAccessor.Vote(context.Background(), "address4", "2", true, 1, time.Now())
 	require.Nil(t, err)
-	require.Equal(t, 3, upVotes)
-	require.Equal(t, 0, downVotes)
+	require.Equal(t, 3, counts.UpVotes)
+	require.Equal(t, 0, counts.DownVotes)
 	res, err = cl.Translation.SubmitTranslation(&translation.SubmitTranslationParams{
 		Translation: signedSubmitTransactionRequest(&models.SubmitTranslationRequest{
 			Word: 1, Language: "id", Name: "new name 2", Description: "description", Timestamp: "2020-01-01T10:00:00+01:00",
@@ -153,11 +177,11 @@ func Test_vote(t *testing.T) {
 	s, dbAccessor, cl, nodeClient := startTestServer()
 	defer s.Stop()
 
-	translationId, err := dbAccessor.SubmitTranslation("translationAuthorAddress", 1, "id", "name", "description", time.Now(), 3)
+	translationId, err := dbAccessor.SubmitTranslation(context.Background(), "translationAuthorAddress", 1, "id", "name", "description", time.Now(), db.Scoring{ConfirmedRate: 3})
 	require.Nil(t, err)
 	require.Equal(t, "1", *translationId)
-	nodeClient.IdentitiesByAddr["translationAuthorAddress"] = true
-	nodeClient.IdentitiesByAddr["address2"] = true
+	nodeClient.IdentitiesByAddr["translationAuthorAddress"] = node.Identity{State: "Verified"}
+	nodeClient.IdentitiesByAddr["address2"] = node.Identity{State: "Verified"}
 
 	// When
 	res, err := cl.Translation.Vote(&translation.VoteParams{
@@ -251,7 +275,7 @@ func Test_vote(t *testing.T) {
 	require.Equal(t, int64(0), res.GetPayload().UpVotes)
 	require.Equal(t, int64(1), res.GetPayload().DownVotes)
 	require.Empty(t, res.GetPayload().Error)
-	translations, _, err := dbAccessor.GetTranslations(1, "id", "", 1, 1)
+	translations, _, err := dbAccessor.GetTranslations(context.Background(), 1, "id", nil, 1, db.Scoring{ConfirmedRate: 1}, "")
 	require.Nil(t, err)
 	require.Zero(t, translations[0].UpVotes)
 	require.Equal(t, 1, translations[0].DownVotes)
@@ -269,7 +293,7 @@ func Test_fullFlow(t *testing.T) {
 		"address5",
 	}
 	for _, address := range addresses {
-		nodeClient.IdentitiesByAddr[address] = true
+		nodeClient.IdentitiesByAddr[address] = node.Identity{State: "Verified"}
 	}
 
 	submitRes, err := cl.Translation.SubmitTranslation(&translation.SubmitTranslationParams{
@@ -458,65 +482,2891 @@ func Test_fullFlow(t *testing.T) {
 	require.True(t, listRes.GetPayload().Translations[0].Confirmed)
 }
 
-func signedSubmitTransactionRequest(
-	r *models.SubmitTranslationRequest,
-	address string,
-	addressesByValueAndSignature map[string]string,
-) *models.SubmitTranslationRequest {
-	val := strings.Join([]string{fmt.Sprint(r.Word), r.Language, r.Name, r.Description, r.Timestamp}, "")
-	signature := val
-	addressesByValueAndSignature[val+signature] = address
-	r.Signature = signature
-	return r
+func Test_translationHistory(t *testing.T) {
+	s, dbAccessor, cl, nodeClient := startTestServer()
+	defer s.Stop()
+
+	address1 := "address1"
+	nodeClient.IdentitiesByAddr[address1] = node.Identity{State: "Verified"}
+
+	submit := func(name, timestamp string) string {
+		res, err := cl.Translation.SubmitTranslation(&translation.SubmitTranslationParams{
+			Translation: signedSubmitTransactionRequest(&models.SubmitTranslationRequest{
+				Word: 1, Language: "id", Name: name, Description: "description " + name, Timestamp: timestamp,
+			}, address1, nodeClient.AddressesByValueAndSignature),
+			Context: context.Background(),
+		})
+		require.Nil(t, err)
+		require.Equal(t, int64(types.SuccessResCode), res.GetPayload().ResCode)
+		return res.GetPayload().TranslationID
+	}
+
+	// When
+	id1 := submit("name1", "2020-01-01T01:00:00Z")
+	historyRes, err := cl.Translation.GetTranslationHistory(&translation.GetTranslationHistoryParams{
+		ID: id1, Context: context.Background(),
+	})
+	// Then
+	require.Nil(t, err)
+	require.Empty(t, historyRes.GetPayload().Revisions)
+
+	// When
+	_, err = dbAccessor.Vote(context.Background(), "address2", id1, true, 1, time.Now())
+	require.Nil(t, err)
+	id2 := submit("name2", "2020-01-01T02:00:00Z")
+	_, err = dbAccessor.Vote(context.Background(), "address2", id2, false, 1, time.Now())
+	require.Nil(t, err)
+	id3 := submit("name3", "2020-01-01T03:00:00Z")
+	historyRes, err = cl.Translation.GetTranslationHistory(&translation.GetTranslationHistoryParams{
+		ID: id3, Context: context.Background(),
+	})
+	// Then
+	require.Nil(t, err)
+	revisions := historyRes.GetPayload().Revisions
+	require.Equal(t, 2, len(revisions))
+	require.Equal(t, id2, revisions[0].ID)
+	require.Equal(t, "name2", revisions[0].Name)
+	require.Equal(t, "description name2", revisions[0].Description)
+	require.Zero(t, revisions[0].UpVotes)
+	require.Equal(t, int64(1), revisions[0].DownVotes)
+	require.Equal(t, "2020-01-01T02:00:00Z", revisions[0].Timestamp)
+	require.Equal(t, id1, revisions[1].ID)
+	require.Equal(t, "name1", revisions[1].Name)
+	require.Equal(t, int64(1), revisions[1].UpVotes)
+	require.Zero(t, revisions[1].DownVotes)
+
+	// When
+	historyRes, err = cl.Translation.GetTranslationHistory(&translation.GetTranslationHistoryParams{
+		ID: id1, Context: context.Background(),
+	})
+	// Then
+	require.Nil(t, err)
+	require.Equal(t, 2, len(historyRes.GetPayload().Revisions))
+
+	// When
+	_, err = cl.Translation.GetTranslationHistory(&translation.GetTranslationHistoryParams{
+		ID: "invalid", Context: context.Background(),
+	})
+	// Then
+	require.NotNil(t, err)
+	require.IsType(t, &translation.GetTranslationHistoryBadRequest{}, err)
 }
 
-func signedVoteRequest(
-	r *models.VoteRequest,
-	address string,
-	addressesByValueAndSignature map[string]string,
-) *models.VoteRequest {
-	val := strings.Join([]string{r.TranslationID, fmt.Sprint(r.Up), r.Timestamp}, "")
-	signature := val
-	addressesByValueAndSignature[val+signature] = address
-	r.Signature = signature
-	return r
+func Test_languages(t *testing.T) {
+	s, _, cl, nodeClient := startTestServer()
+	defer s.Stop()
+
+	address1 := "address1"
+	nodeClient.IdentitiesByAddr[address1] = node.Identity{State: "Verified"}
+
+	// When
+	languagesRes, err := cl.Translation.GetLanguages(&translation.GetLanguagesParams{
+		Context: context.Background(),
+	})
+	// Then
+	require.Nil(t, err)
+	require.Equal(t, 20, len(languagesRes.GetPayload().Languages))
+	require.Equal(t, "id", languagesRes.GetPayload().Languages[0].Name)
+	require.True(t, languagesRes.GetPayload().Languages[0].Enabled)
+	require.Zero(t, languagesRes.GetPayload().Languages[0].Coverage)
+
+	// When
+	_, err = cl.Translation.AddLanguage(&translation.AddLanguageParams{
+		APIKey: "wrongKey", Language: &models.AddLanguageRequest{Language: "pt-br"}, Context: context.Background(),
+	})
+	// Then
+	require.NotNil(t, err)
+	require.IsType(t, &translation.AddLanguageForbidden{}, err)
+
+	// When
+	_, err = cl.Translation.AddLanguage(&translation.AddLanguageParams{
+		APIKey: adminApiKey, Language: &models.AddLanguageRequest{Language: "not a language"}, Context: context.Background(),
+	})
+	// Then
+	require.NotNil(t, err)
+	require.IsType(t, &translation.AddLanguageBadRequest{}, err)
+
+	// When
+	addRes, err := cl.Translation.AddLanguage(&translation.AddLanguageParams{
+		APIKey: adminApiKey, Language: &models.AddLanguageRequest{Language: "pt-br"}, Context: context.Background(),
+	})
+	// Then
+	require.Nil(t, err)
+	require.Equal(t, "pt-BR", addRes.GetPayload().Name)
+	require.True(t, addRes.GetPayload().Enabled)
+
+	// When
+	submitRes, err := cl.Translation.SubmitTranslation(&translation.SubmitTranslationParams{
+		Translation: signedSubmitTransactionRequest(&models.SubmitTranslationRequest{
+			Word: 1, Language: "PT-br", Name: "name", Description: "description", Timestamp: "2020-01-01T01:00:00Z",
+		}, address1, nodeClient.AddressesByValueAndSignature),
+		Context: context.Background(),
+	})
+	// Then
+	require.Nil(t, err)
+	require.Equal(t, int64(types.SuccessResCode), submitRes.GetPayload().ResCode)
+	listRes, err := cl.Translation.GetTranslations(&translation.GetTranslationsParams{
+		Word: 1, Language: "pt-BR", Context: context.Background(),
+	})
+	require.Nil(t, err)
+	require.Equal(t, 1, len(listRes.GetPayload().Translations))
+
+	// When
+	_, err = cl.Translation.GetTranslations(&translation.GetTranslationsParams{
+		Word: 1, Language: "not a language", Context: context.Background(),
+	})
+	// Then
+	require.NotNil(t, err)
+	require.IsType(t, &translation.GetTranslationsBadRequest{}, err)
+
+	// When
+	disableRes, err := cl.Translation.DisableLanguage(&translation.DisableLanguageParams{
+		APIKey: adminApiKey, Language: "pt-BR", Context: context.Background(),
+	})
+	// Then
+	require.Nil(t, err)
+	require.Equal(t, "pt-BR", disableRes.GetPayload().Name)
+	require.False(t, disableRes.GetPayload().Enabled)
+	_, err = cl.Translation.SubmitTranslation(&translation.SubmitTranslationParams{
+		Translation: signedSubmitTransactionRequest(&models.SubmitTranslationRequest{
+			Word: 1, Language: "pt-BR", Name: "name", Description: "description", Timestamp: "2020-01-01T02:00:00Z",
+		}, address1, nodeClient.AddressesByValueAndSignature),
+		Context: context.Background(),
+	})
+	require.NotNil(t, err)
+	require.IsType(t, &translation.SubmitTranslationBadRequest{}, err)
+	listRes, err = cl.Translation.GetTranslations(&translation.GetTranslationsParams{
+		Word: 1, Language: "pt-BR", Context: context.Background(),
+	})
+	require.Nil(t, err)
+	require.Equal(t, 1, len(listRes.GetPayload().Translations))
+
+	// When
+	_, err = cl.Translation.DisableLanguage(&translation.DisableLanguageParams{
+		APIKey: adminApiKey, Language: "pt-PT", Context: context.Background(),
+	})
+	// Then
+	require.NotNil(t, err)
+	require.IsType(t, &translation.DisableLanguageBadRequest{}, err)
+
+	// When
+	languagesRes, err = cl.Translation.GetLanguages(&translation.GetLanguagesParams{
+		Context: context.Background(),
+	})
+	// Then
+	require.Nil(t, err)
+	require.Equal(t, 21, len(languagesRes.GetPayload().Languages))
+	require.Equal(t, "pt-BR", languagesRes.GetPayload().Languages[20].Name)
+	require.False(t, languagesRes.GetPayload().Languages[20].Enabled)
 }
 
-func startTestServer() (*server.Server, db.Accessor, *client.IdenaFlipWordsTranslation, *TestNodeClient) {
-	dbConnector, err := sql.Open("postgres", connStr)
-	if err != nil {
-		panic(err)
+func Test_languageCoverage(t *testing.T) {
+	// Word 2 duplicates word 0, so the list has 4 distinct words
+	wordsServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"words":[{"name":"w0"},{"name":"w1"},{"name":"w0"},{"name":"w3"},{"name":"w4"}]}`)
+	}))
+	defer wordsServer.Close()
+	s, _, cl, nodeClient := startTestServerWithConfig(config.ServerConfig{Port: port}, testEngineConfig{
+		scoring:  db.Scoring{ConfirmedRate: 1},
+		wordsUrl: wordsServer.URL,
+	})
+	defer s.Stop()
+	for _, address := range []string{"address1", "address2", "address3"} {
+		nodeClient.IdentitiesByAddr[address] = node.Identity{State: "Verified"}
 	}
-	_, err = dbConnector.Exec("DROP SCHEMA IF EXISTS " + schema + " CASCADE")
-	if err != nil {
-		panic(err)
+	submit := func(word uint32, address string) string {
+		res, err := cl.Translation.SubmitTranslation(&translation.SubmitTranslationParams{
+			Translation: signedSubmitTransactionRequest(&models.SubmitTranslationRequest{
+				Word: int64(word), Language: "id", Name: "name", Timestamp: time.Now().UTC().Format(time.RFC3339),
+			}, address, nodeClient.AddressesByValueAndSignature),
+			Context: context.Background(),
+		})
+		require.Nil(t, err)
+		require.Equal(t, int64(types.SuccessResCode), res.GetPayload().ResCode)
+		return res.GetPayload().TranslationID
 	}
-	_, err = dbConnector.Exec("CREATE SCHEMA " + schema)
-	if err != nil {
-		panic(err)
+	translationId := submit(0, "address1")
+	submit(0, "address2")
+	submit(1, "address1")
+	voteRes, err := cl.Translation.Vote(&translation.VoteParams{
+		Vote: signedVoteRequest(&models.VoteRequest{
+			TranslationID: translationId, Up: true, Timestamp: time.Now().UTC().Format(time.RFC3339),
+		}, "address3", nodeClient.AddressesByValueAndSignature),
+		Context: context.Background(),
+	})
+	require.Nil(t, err)
+	require.Equal(t, int64(types.SuccessResCode), voteRes.GetPayload().ResCode)
+
+	// When
+	res, err := cl.Translation.GetLanguages(&translation.GetLanguagesParams{
+		Context: context.Background(),
+	})
+	// Then
+	require.Nil(t, err)
+	require.Equal(t, &models.LanguageCoverage{Name: "id", Enabled: true, TranslatedWords: 2, ConfirmedWords: 1, Coverage: 25},
+		res.GetPayload().Languages[0])
+	require.Equal(t, &models.LanguageCoverage{Name: "fr", Enabled: true}, res.GetPayload().Languages[1])
+}
+
+func Test_exportConfirmedTranslations(t *testing.T) {
+	s, dbAccessor, _, _ := startTestServer()
+	defer s.Stop()
+
+	for wordId := uint32(1); wordId <= 3; wordId++ {
+		translationId, err := dbAccessor.SubmitTranslation(context.Background(), "author", wordId, "id", fmt.Sprintf("name%d", wordId), "description \"quoted\"", time.Now(), db.Scoring{ConfirmedRate: 3})
+		require.Nil(t, err)
+		votes := 3
+		if wordId == 2 {
+			votes = 2
+		}
+		for i := 0; i < votes; i++ {
+			_, err := dbAccessor.Vote(context.Background(), fmt.Sprintf("voter%d", i), *translationId, true, 1, time.Now())
+			require.Nil(t, err)
+		}
 	}
-	dbAccessor := postgres.NewAccessor(connStr+"&search_path="+schema, "../resources")
-	nodeClient := &TestNodeClient{
-		IdentitiesByAddr:             make(map[string]bool),
-		AddressesByValueAndSignature: make(map[string]string),
+	export := func(language, format string) (int, string, string) {
+		resp, err := http.Get(fmt.Sprintf("http://localhost:%v/language/%v/confirmed-translations?format=%v", port, language, format))
+		require.Nil(t, err)
+		defer resp.Body.Close()
+		body, err := ioutil.ReadAll(resp.Body)
+		require.Nil(t, err)
+		return resp.StatusCode, resp.Header.Get("Content-Type"), string(body)
 	}
-	auth := core.NewEngine(dbAccessor, nodeClient, 5, 3, words_mapper.NewWordsMapper(""))
-	s := server.NewServer(port, auth)
-	go s.Start(config.SwaggerConfig{})
-	clConfig := client.DefaultTransportConfig().WithHost(fmt.Sprintf("localhost:%v", port))
-	cl := client.NewHTTPClientWithConfig(nil, clConfig)
-	return s, dbAccessor, cl, nodeClient
+
+	// When
+	code, contentType, body := export("id", "json")
+	// Then
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, "application/json", contentType)
+	require.JSONEq(t, `{"1":{"name":"name1","description":"description \"quoted\""},"3":{"name":"name3","description":"description \"quoted\""}}`, body)
+
+	// When
+	code, contentType, body = export("ID", "csv")
+	// Then
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, "text/csv; charset=utf-8", contentType)
+	require.Equal(t, "word,name,description\n1,name1,\"description \"\"quoted\"\"\"\n3,name3,\"description \"\"quoted\"\"\"\n", body)
+
+	// When
+	code, _, body = export("id", "po")
+	// Then
+	require.Equal(t, http.StatusOK, code)
+	require.Contains(t, body, "\"Language: id\\n\"")
+	require.Contains(t, body, "msgctxt \"3/name\"\nmsgid \"3/name\"\nmsgstr \"name3\"\n")
+	require.Contains(t, body, "msgctxt \"3/desc\"\nmsgid \"3/desc\"\nmsgstr \"description \\\"quoted\\\"\"\n")
+	require.NotContains(t, body, "2/name")
+
+	// When
+	code, _, body = export("fr", "json")
+	// Then
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, "{}", body)
+
+	// When
+	code, _, _ = export("id", "xml")
+	// Then
+	require.Equal(t, http.StatusBadRequest, code)
 }
 
-type TestNodeClient struct {
-	IdentitiesByAddr             map[string]bool
-	AddressesByValueAndSignature map[string]string
+func Test_importTranslations(t *testing.T) {
+	s, dbAccessor, cl, _ := startTestServer()
+	defer s.Stop()
+
+	translationId, err := dbAccessor.SubmitTranslation(context.Background(), "address1", 1, "id", "name", "description", time.Now(), db.Scoring{ConfirmedRate: 3})
+	require.Nil(t, err)
+	csvFile := "word,name,description,address,upVotes\n" +
+		"1,name1,description1,ADDRESS1,\n" +
+		"2,name2,description2,,5\n" +
+		"4616,name3,description3,,\n" +
+		"2,name4,description4,,\n" +
+		"3,name5,description5,address2,\n"
+	importTranslations := func(query, apiKey string) (int, types.ImportTranslationsResponse) {
+		req, err := http.NewRequest("POST", fmt.Sprintf("http://localhost:%v/translations/import?%v", port, query), strings.NewReader(csvFile))
+		require.Nil(t, err)
+		req.Header.Set("Content-Type", "text/csv")
+		req.Header.Set("api-key", apiKey)
+		resp, err := http.DefaultClient.Do(req)
+		require.Nil(t, err)
+		defer resp.Body.Close()
+		var res types.ImportTranslationsResponse
+		if resp.StatusCode == http.StatusOK {
+			require.Nil(t, json.NewDecoder(resp.Body).Decode(&res))
+		}
+		return resp.StatusCode, res
+	}
+
+	// When
+	code, _ := importTranslations("format=csv&language=id&source=sheet", "wrongKey")
+	// Then
+	require.Equal(t, http.StatusForbidden, code)
+
+	// When
+	code, _ = importTranslations("format=csv&language=id&source=", adminApiKey)
+	// Then
+	require.Equal(t, http.StatusBadRequest, code)
+
+	// When
+	code, report := importTranslations("format=csv&language=id&source=sheet&dry-run=true", adminApiKey)
+	// Then
+	require.Equal(t, http.StatusOK, code)
+	require.True(t, report.DryRun)
+	require.Equal(t, 2, report.Imported)
+	require.Equal(t, []types.ImportConflict{{Row: 0, Word: 1, Language: "id", Address: "ADDRESS1", TranslationId: *translationId}}, report.Conflicts)
+	require.Equal(t, 2, len(report.Errors))
+	require.Equal(t, 2, report.Errors[0].Row)
+	require.Equal(t, 3, report.Errors[1].Row)
+	listRes, err := cl.Translation.GetTranslations(&translation.GetTranslationsParams{
+		Word: 2, Language: "id", Context: context.Background(),
+	})
+	require.Nil(t, err)
+	require.Empty(t, listRes.GetPayload().Translations)
+
+	// When
+	code, report = importTranslations("format=csv&language=id&source=sheet", adminApiKey)
+	// Then
+	require.Equal(t, http.StatusOK, code)
+	require.False(t, report.DryRun)
+	require.Equal(t, 2, report.Imported)
+	confirmedRes, err := cl.Translation.GetConfirmedTranslation(&translation.GetConfirmedTranslationParams{
+		Word: 2, Language: "id", Context: context.Background(),
+	})
+	require.Nil(t, err)
+	require.NotNil(t, confirmedRes.GetPayload().Translation)
+	require.Equal(t, "name2", confirmedRes.GetPayload().Translation.Name)
+	require.Equal(t, int64(5), confirmedRes.GetPayload().Translation.UpVotes)
+	listRes, err = cl.Translation.GetTranslations(&translation.GetTranslationsParams{
+		Word: 3, Language: "id", Context: context.Background(),
+	})
+	require.Nil(t, err)
+	require.Equal(t, 1, len(listRes.GetPayload().Translations))
+	require.Equal(t, "name5", listRes.GetPayload().Translations[0].Name)
+
+	// When
+	code, report = importTranslations("format=csv&language=id&source=sheet", adminApiKey)
+	// Then
+	require.Equal(t, http.StatusOK, code)
+	require.Zero(t, report.Imported)
+	require.Equal(t, 3, len(report.Conflicts))
 }
 
-func (t *TestNodeClient) GetSignatureAddress(value, signature string) (string, error) {
-	return t.AddressesByValueAndSignature[value+signature], nil
+func Test_continuationToken(t *testing.T) {
+	s, dbAccessor, cl, _ := startTestServer()
+	defer s.Stop()
+
+	for i := 0; i < 7; i++ {
+		_, err := dbAccessor.SubmitTranslation(context.Background(), fmt.Sprintf("address%v", i), 1, "id", "name", "description", time.Now(), db.Scoring{ConfirmedRate: 3})
+		require.Nil(t, err)
+	}
+	listRes, err := cl.Translation.GetTranslations(&translation.GetTranslationsParams{
+		Word: 1, Language: "id", Context: context.Background(),
+	})
+	require.Nil(t, err)
+	require.Equal(t, 5, len(listRes.GetPayload().Translations))
+	token := listRes.ContinuationToken
+	require.NotEmpty(t, token)
+
+	getTranslations := func(word int64, language string, token string) (*translation.GetTranslationsOK, error) {
+		return cl.Translation.GetTranslations(&translation.GetTranslationsParams{
+			Word: word, Language: language, ContinuationToken: &token, Context: context.Background(),
+		})
+	}
+	requireBadRequest := func(err error, message string) {
+		badRequest, ok := err.(*translation.GetTranslationsBadRequest)
+		require.True(t, ok)
+		require.Equal(t, message, badRequest.GetPayload().Error)
+	}
+
+	// When
+	_, err = getTranslations(2, "id", token)
+	// Then
+	requireBadRequest(err, types.MismatchedContinuationTokenError.Error())
+
+	// When
+	_, err = getTranslations(1, "fr", token)
+	// Then
+	requireBadRequest(err, types.MismatchedContinuationTokenError.Error())
+
+	// When
+	tampered := []byte(token)
+	tampered[len(tampered)/2] ^= 1
+	_, err = getTranslations(1, "id", string(tampered))
+	// Then
+	requireBadRequest(err, types.InvalidContinuationTokenError.Error())
+
+	// When
+	_, err = getTranslations(1, "id", hex.EncodeToString([]byte("1|0")))
+	// Then
+	requireBadRequest(err, types.InvalidContinuationTokenError.Error())
+
+	// When
+	expiredToken := continuation.NewCodec([]byte("secret"), -time.Minute).
+		Encode(1, "id", continuation.RatingOrder, db.TranslationsCursor{Id: 6})
+	_, err = getTranslations(1, "id", expiredToken)
+	// Then
+	requireBadRequest(err, types.ExpiredContinuationTokenError.Error())
+
+	// When
+	forgedToken := continuation.NewCodec([]byte("anotherSecret"), time.Hour).
+		Encode(1, "id", continuation.RatingOrder, db.TranslationsCursor{Id: 6})
+	_, err = getTranslations(1, "id", forgedToken)
+	// Then
+	requireBadRequest(err, types.InvalidContinuationTokenError.Error())
+
+	// When
+	listRes, err = getTranslations(1, "ID", token)
+	// Then
+	require.Nil(t, err)
+	require.Equal(t, 2, len(listRes.GetPayload().Translations))
+	require.Empty(t, listRes.ContinuationToken)
 }
 
-func (t *TestNodeClient) IsIdentity(address string) (bool, error) {
-	return t.IdentitiesByAddr[address], nil
+func Test_pagination(t *testing.T) {
+	s, dbAccessor, cl, _ := startTestServer()
+	defer s.Stop()
+
+	rates := []int{0, 2, 1, 2, -1, 0, 3, 1, 2, 0, 1, -1}
+	type item struct {
+		id   string
+		rate int
+	}
+	var items []item
+	for i, rate := range rates {
+		translationId, err := dbAccessor.SubmitTranslation(context.Background(), fmt.Sprintf("address%v", i), 1, "id", "name", "description", time.Now(), db.Scoring{ConfirmedRate: 10})
+		require.Nil(t, err)
+		for j := 0; j < rate || j < -rate; j++ {
+			_, err := dbAccessor.Vote(context.Background(), fmt.Sprintf("voter%v", j), *translationId, rate > 0, 1, time.Now())
+			require.Nil(t, err)
+		}
+		items = append(items, item{*translationId, rate})
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].rate > items[j].rate
+	})
+	var expectedIds []string
+	for _, item := range items {
+		expectedIds = append(expectedIds, item.id)
+	}
+	getPage := func(token string, withTotal bool) *translation.GetTranslationsOK {
+		params := &translation.GetTranslationsParams{
+			Word: 1, Language: "id", Total: &withTotal, Context: context.Background(),
+		}
+		if len(token) > 0 {
+			params.ContinuationToken = &token
+		}
+		res, err := cl.Translation.GetTranslations(params)
+		require.Nil(t, err)
+		return res
+	}
+	pageIds := func(res *translation.GetTranslationsOK) []string {
+		var ids []string
+		for _, item := range res.GetPayload().Translations {
+			ids = append(ids, item.ID)
+		}
+		return ids
+	}
+
+	// When
+	var pages []*translation.GetTranslationsOK
+	page := getPage("", true)
+	pages = append(pages, page)
+	for len(page.ContinuationToken) > 0 {
+		page = getPage(page.ContinuationToken, false)
+		pages = append(pages, page)
+	}
+	// Then
+	require.Equal(t, 3, len(pages))
+	require.Equal(t, int64(len(rates)), pages[0].GetPayload().Total)
+	require.Zero(t, pages[1].GetPayload().Total)
+	require.Empty(t, pages[0].PrevContinuationToken)
+	require.NotEmpty(t, pages[1].PrevContinuationToken)
+	require.NotEmpty(t, pages[2].PrevContinuationToken)
+	var ids []string
+	for _, page := range pages {
+		ids = append(ids, pageIds(page)...)
+	}
+	require.Equal(t, expectedIds, ids)
+
+	// When
+	prevPage := getPage(pages[2].PrevContinuationToken, false)
+	// Then
+	require.Equal(t, expectedIds[5:10], pageIds(prevPage))
+
+	// When
+	prevPage = getPage(prevPage.PrevContinuationToken, false)
+	// Then
+	require.Equal(t, expectedIds[:5], pageIds(prevPage))
+	require.Empty(t, prevPage.PrevContinuationToken)
+	require.NotEmpty(t, prevPage.ContinuationToken)
+
+	// When
+	nextPage := getPage(prevPage.ContinuationToken, false)
+	// Then
+	require.Equal(t, expectedIds[5:10], pageIds(nextPage))
+}
+
+func Test_identityCache(t *testing.T) {
+	nodeClient := &TestNodeClient{
+		IdentitiesByAddr: map[string]node.Identity{"address1": {State: "Human", Age: 5}},
+	}
+	cachingClient := node.NewCachingClient(nodeClient, time.Hour, time.Millisecond*10)
+	time.Sleep(time.Millisecond * 50)
+
+	// When
+	identity, err := cachingClient.GetIdentity(context.Background(), "address1")
+	// Then
+	require.Nil(t, err)
+	require.Equal(t, node.Identity{State: "Human", Age: 5}, identity)
+	require.Equal(t, node.CacheStats{Hits: 0, Misses: 1}, cachingClient.Stats())
+
+	// When
+	nodeClient.IdentitiesByAddr["address1"] = node.Identity{State: "Suspended", Age: 5}
+	identity, err = cachingClient.GetIdentity(context.Background(), "ADDRESS1")
+	// Then
+	require.Nil(t, err)
+	require.Equal(t, "Human", identity.State)
+	require.Equal(t, node.CacheStats{Hits: 1, Misses: 1}, cachingClient.Stats())
+
+	// When
+	nodeClient.SetEpoch(1)
+	// Then
+	require.Eventually(t, func() bool {
+		identity, err := cachingClient.GetIdentity(context.Background(), "address1")
+		return err == nil && identity.State == "Suspended"
+	}, time.Second, time.Millisecond*10)
+
+	// When
+	shortLivedCache := node.NewCachingClient(nodeClient, time.Millisecond*20, 0)
+	_, _ = shortLivedCache.GetIdentity(context.Background(), "address1")
+	time.Sleep(time.Millisecond * 40)
+	_, _ = shortLivedCache.GetIdentity(context.Background(), "address1")
+	// Then
+	require.Equal(t, node.CacheStats{Hits: 0, Misses: 2}, shortLivedCache.Stats())
+}
+
+func Test_voteWeights(t *testing.T) {
+	s, dbAccessor, cl, nodeClient := startTestServerWithConfig(config.ServerConfig{Port: port}, testEngineConfig{
+		scoring:     db.Scoring{ConfirmedRate: 3, Weighted: true},
+		voteWeights: core.VoteWeights{"Newbie": 1, "Verified": 1, "Human": 3},
+	})
+	defer s.Stop()
+	newbieTranslationId, err := dbAccessor.SubmitTranslation(context.Background(), "author1", 1, "id", "name1", "description", time.Now(), db.Scoring{ConfirmedRate: 3})
+	require.Nil(t, err)
+	humanTranslationId, err := dbAccessor.SubmitTranslation(context.Background(), "author2", 1, "id", "name2", "description", time.Now(), db.Scoring{ConfirmedRate: 3})
+	require.Nil(t, err)
+	nodeClient.IdentitiesByAddr["newbie1"] = node.Identity{State: "Newbie", Age: 1}
+	nodeClient.IdentitiesByAddr["newbie2"] = node.Identity{State: "Newbie", Age: 2}
+	nodeClient.IdentitiesByAddr["human"] = node.Identity{State: "Human", Age: 10}
+	vote := func(translationId string, up bool, timestamp string, address string) *models.VoteResponse {
+		res, err := cl.Translation.Vote(&translation.VoteParams{
+			Vote: signedVoteRequest(&models.VoteRequest{
+				TranslationID: translationId, Up: up, Timestamp: timestamp,
+			}, address, nodeClient.AddressesByValueAndSignature),
+			Context: context.Background(),
+		})
+		require.Nil(t, err)
+		require.Equal(t, int64(types.SuccessResCode), res.GetPayload().ResCode)
+		return res.GetPayload()
+	}
+
+	// When
+	vote(*newbieTranslationId, true, "2020-01-01T01:00:00Z", "newbie1")
+	voteRes := vote(*newbieTranslationId, true, "2020-01-01T01:00:00Z", "newbie2")
+	// Then
+	require.Equal(t, int64(2), voteRes.UpVotes)
+	require.Equal(t, 2.0, voteRes.WeightedUpVotes)
+
+	// When
+	voteRes = vote(*humanTranslationId, true, "2020-01-01T01:00:00Z", "human")
+	// Then
+	require.Equal(t, int64(1), voteRes.UpVotes)
+	require.Equal(t, 3.0, voteRes.WeightedUpVotes)
+	listRes, err := cl.Translation.GetTranslations(&translation.GetTranslationsParams{
+		Word: 1, Language: "id", Context: context.Background(),
+	})
+	require.Nil(t, err)
+	require.Len(t, listRes.GetPayload().Translations, 2)
+	require.Equal(t, *humanTranslationId, listRes.GetPayload().Translations[0].ID)
+	require.True(t, listRes.GetPayload().Translations[0].Confirmed)
+	require.Equal(t, *newbieTranslationId, listRes.GetPayload().Translations[1].ID)
+	require.False(t, listRes.GetPayload().Translations[1].Confirmed)
+	confirmedRes, err := cl.Translation.GetConfirmedTranslation(&translation.GetConfirmedTranslationParams{
+		Word: 1, Language: "id", Context: context.Background(),
+	})
+	require.Nil(t, err)
+	require.NotNil(t, confirmedRes.GetPayload().Translation)
+	require.Equal(t, *humanTranslationId, confirmedRes.GetPayload().Translation.ID)
+
+	// When
+	voteRes = vote(*humanTranslationId, false, "2020-01-01T02:00:00Z", "human")
+	// Then
+	require.Equal(t, 0.0, voteRes.WeightedUpVotes)
+	require.Equal(t, 3.0, voteRes.WeightedDownVotes)
+
+	// When
+	nodeClient.IdentitiesByAddr["human"] = node.Identity{State: "Verified", Age: 11}
+	voteRes = vote(*humanTranslationId, true, "2020-01-01T03:00:00Z", "human")
+	// Then
+	require.Equal(t, int64(1), voteRes.UpVotes)
+	require.Equal(t, int64(0), voteRes.DownVotes)
+	require.Equal(t, 1.0, voteRes.WeightedUpVotes)
+	require.Equal(t, 0.0, voteRes.WeightedDownVotes)
+	listRes, err = cl.Translation.GetTranslations(&translation.GetTranslationsParams{
+		Word: 1, Language: "id", Context: context.Background(),
+	})
+	require.Nil(t, err)
+	require.Equal(t, *newbieTranslationId, listRes.GetPayload().Translations[0].ID)
+	require.Equal(t, *humanTranslationId, listRes.GetPayload().Translations[1].ID)
+}
+
+func Test_revalidation(t *testing.T) {
+	s, dbAccessor, cl, nodeClient := startTestServer()
+	defer s.Stop()
+	scoring := db.Scoring{ConfirmedRate: 3}
+	translationId1, err := dbAccessor.SubmitTranslation(context.Background(), "author1", 1, "id", "name1", "description", time.Now(), scoring)
+	require.Nil(t, err)
+	translationId2, err := dbAccessor.SubmitTranslation(context.Background(), "author2", 2, "id", "name2", "description", time.Now(), scoring)
+	require.Nil(t, err)
+	for _, address := range []string{"author1", "author2", "voter1", "voter2", "voter3"} {
+		nodeClient.IdentitiesByAddr[address] = node.Identity{State: "Verified"}
+		if strings.HasPrefix(address, "voter") {
+			_, err := dbAccessor.Vote(context.Background(), address, *translationId1, true, 1, time.Now())
+			require.Nil(t, err)
+			_, err = dbAccessor.Vote(context.Background(), address, *translationId2, true, 1, time.Now())
+			require.Nil(t, err)
+		}
+	}
+	getConfirmed := func(wordId int64) *models.Translation {
+		res, err := cl.Translation.GetConfirmedTranslation(&translation.GetConfirmedTranslationParams{
+			Word: wordId, Language: "id", Context: context.Background(),
+		})
+		require.Nil(t, err)
+		return res.GetPayload().Translation
+	}
+	revalidator := core.NewRevalidator(dbAccessor, nodeClient, scoring, nil, 2)
+	require.NotNil(t, getConfirmed(1))
+	require.NotNil(t, getConfirmed(2))
+
+	// When
+	nodeClient.IdentitiesByAddr["voter1"] = node.Identity{State: "Killed"}
+	nodeClient.IdentitiesByAddr["author2"] = node.Identity{State: "Suspended"}
+	res, err := revalidator.Revalidate(context.Background(), 1)
+	// Then
+	require.Nil(t, err)
+	require.Equal(t, db.RevalidationResult{ChangedVotes: 2, ChangedTranslations: 2, ChangedConfirmations: 2}, res)
+	require.Nil(t, getConfirmed(1))
+	require.Nil(t, getConfirmed(2))
+	translations, _, err := dbAccessor.GetTranslations(context.Background(), 1, "id", nil, 5, scoring, "")
+	require.Nil(t, err)
+	require.Equal(t, 2, translations[0].UpVotes)
+	require.Equal(t, 2.0, translations[0].WeightedUpVotes)
+	epoch, err := dbAccessor.LastRevalidatedEpoch(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, uint16(1), *epoch)
+
+	// When
+	res, err = revalidator.Revalidate(context.Background(), 1)
+	// Then
+	require.Nil(t, err)
+	require.Equal(t, db.RevalidationResult{}, res)
+
+	// When
+	nodeClient.IdentitiesByAddr["voter1"] = node.Identity{State: "Newbie"}
+	nodeClient.IdentitiesByAddr["voter2"] = node.Identity{State: "Suspended"}
+	nodeClient.IdentitiesByAddr["author2"] = node.Identity{State: "Verified"}
+	res, err = revalidator.Revalidate(context.Background(), 2)
+	// Then
+	require.Nil(t, err)
+	require.Equal(t, db.RevalidationResult{ChangedVotes: 4, ChangedTranslations: 2, ChangedConfirmations: 0}, res)
+	require.Nil(t, getConfirmed(1))
+	require.Nil(t, getConfirmed(2))
+
+	// When
+	nodeClient.IdentitiesByAddr["voter2"] = node.Identity{State: "Verified"}
+	counts, err := dbAccessor.Vote(context.Background(), "voter2", *translationId1, false, 1, time.Now())
+	// Then
+	require.Nil(t, err)
+	require.Equal(t, db.VoteCounts{UpVotes: 2, DownVotes: 1, WeightedUpVotes: 2, WeightedDownVotes: 1}, counts)
+
+	// When
+	res, err = revalidator.Revalidate(context.Background(), 3)
+	// Then
+	require.Nil(t, err)
+	require.Equal(t, db.RevalidationResult{ChangedVotes: 1, ChangedTranslations: 1, ChangedConfirmations: 1}, res)
+	require.Nil(t, getConfirmed(1))
+	require.NotNil(t, getConfirmed(2))
+}
+
+func Test_confirmedTranslationOfExcludedAuthor(t *testing.T) {
+	scoring := db.Scoring{ConfirmedRate: 2}
+	s, dbAccessor, cl, nodeClient := startTestServerWithConfig(config.ServerConfig{Port: port}, testEngineConfig{
+		scoring: scoring,
+	})
+	defer s.Stop()
+	translationId1, err := dbAccessor.SubmitTranslation(context.Background(), "author1", 1, "id", "name1", "description", time.Now(), scoring)
+	require.Nil(t, err)
+	translationId2, err := dbAccessor.SubmitTranslation(context.Background(), "author2", 1, "id", "name2", "description", time.Now(), scoring)
+	require.Nil(t, err)
+	for _, address := range []string{"author1", "author2", "voter1", "voter2", "voter3"} {
+		nodeClient.IdentitiesByAddr[address] = node.Identity{State: "Verified"}
+	}
+	for _, address := range []string{"voter1", "voter2", "voter3"} {
+		_, err := dbAccessor.Vote(context.Background(), address, *translationId1, true, 1, time.Now())
+		require.Nil(t, err)
+	}
+	for _, address := range []string{"voter1", "voter2"} {
+		_, err := dbAccessor.Vote(context.Background(), address, *translationId2, true, 1, time.Now())
+		require.Nil(t, err)
+	}
+	getConfirmed := func() *models.Translation {
+		res, err := cl.Translation.GetConfirmedTranslation(&translation.GetConfirmedTranslationParams{
+			Word: 1, Language: "id", Context: context.Background(),
+		})
+		require.Nil(t, err)
+		return res.GetPayload().Translation
+	}
+	require.Equal(t, *translationId1, getConfirmed().ID)
+
+	// When
+	nodeClient.IdentitiesByAddr["author1"] = node.Identity{State: "Killed"}
+	res, err := core.NewRevalidator(dbAccessor, nodeClient, scoring, nil, 2).Revalidate(context.Background(), 1)
+	// Then
+	require.Nil(t, err)
+	require.Equal(t, db.RevalidationResult{ChangedTranslations: 1, ChangedConfirmations: 1}, res)
+	confirmed := getConfirmed()
+	require.NotNil(t, confirmed)
+	require.Equal(t, *translationId2, confirmed.ID)
+	require.Equal(t, int64(2), confirmed.UpVotes)
+	translations, _, err := dbAccessor.GetTranslations(context.Background(), 1, "id", nil, 5, scoring, "")
+	require.Nil(t, err)
+	require.Equal(t, *translationId1, translations[0].Id)
+	require.False(t, translations[0].Confirmed)
+}
+
+func Test_revalidationOfImportedTranslations(t *testing.T) {
+	s, dbAccessor, cl, nodeClient := startTestServer()
+	defer s.Stop()
+	scoring := db.Scoring{ConfirmedRate: 3}
+	_, err := dbAccessor.ImportTranslations(context.Background(), []types.ImportTranslationRow{
+		{Word: 2, Language: "id", Name: "name2", UpVotes: 5},
+		{Word: 3, Language: "id", Name: "name3", Address: "author1", UpVotes: 5},
+	}, "sheet", false)
+	require.Nil(t, err)
+	translationId, err := dbAccessor.SubmitTranslation(context.Background(), "author1", 1, "id", "name1", "description", time.Now(), scoring)
+	require.Nil(t, err)
+	for _, address := range []string{"voter1", "voter2"} {
+		_, err := dbAccessor.Vote(context.Background(), address, *translationId, true, 1, time.Now())
+		require.Nil(t, err)
+	}
+	getConfirmed := func(wordId int64) *models.Translation {
+		res, err := cl.Translation.GetConfirmedTranslation(&translation.GetConfirmedTranslationParams{
+			Word: wordId, Language: "id", Context: context.Background(),
+		})
+		require.Nil(t, err)
+		return res.GetPayload().Translation
+	}
+	revalidator := core.NewRevalidator(dbAccessor, nodeClient, scoring, nil, 2)
+
+	// When
+	participants, err := dbAccessor.GetParticipants(context.Background())
+	// Then
+	require.Nil(t, err)
+	require.Equal(t, []string{"author1", "voter1", "voter2"}, participants)
+
+	// When
+	nodeClient.IdentitiesByAddr["author1"] = node.Identity{State: "Killed"}
+	nodeClient.IdentitiesByAddr["voter2"] = node.Identity{State: "Killed"}
+	nodeClient.IdentityErrorsByAddr = map[string]error{"voter1": errors.New("node is unavailable")}
+	res, err := revalidator.Revalidate(context.Background(), 1)
+	// Then
+	require.Nil(t, err)
+	require.Equal(t, db.RevalidationResult{ChangedVotes: 1, ChangedTranslations: 1}, res)
+	require.NotNil(t, getConfirmed(2))
+	require.NotNil(t, getConfirmed(3))
+	translations, _, err := dbAccessor.GetTranslations(context.Background(), 1, "id", nil, 5, scoring, "")
+	require.Nil(t, err)
+	require.Equal(t, 1, translations[0].UpVotes)
+}
+
+func Test_revalidationConcurrency(t *testing.T) {
+	s, dbAccessor, _, nodeClient := startTestServer()
+	defer s.Stop()
+	scoring := db.Scoring{ConfirmedRate: 3}
+	translationId, err := dbAccessor.SubmitTranslation(context.Background(), "author", 1, "id", "name", "description", time.Now(), scoring)
+	require.Nil(t, err)
+	for i := 0; i < 10; i++ {
+		address := fmt.Sprintf("voter%v", i)
+		nodeClient.IdentitiesByAddr[address] = node.Identity{State: "Killed"}
+		_, err := dbAccessor.Vote(context.Background(), address, *translationId, true, 1, time.Now())
+		require.Nil(t, err)
+	}
+	nodeClient.Delay = time.Millisecond * 20
+
+	// When
+	res, err := core.NewRevalidator(dbAccessor, nodeClient, scoring, nil, 3).Revalidate(context.Background(), 1)
+	// Then
+	require.Nil(t, err)
+	require.Equal(t, 10, res.ChangedVotes)
+	require.Equal(t, int32(3), atomic.LoadInt32(&nodeClient.MaxIdentityRequests))
+
+	// When
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*30)
+	defer cancel()
+	_, err = core.NewRevalidator(dbAccessor, nodeClient, scoring, nil, 3).Revalidate(ctx, 2)
+	// Then
+	require.NotNil(t, err)
+	epoch, err := dbAccessor.LastRevalidatedEpoch(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, uint16(1), *epoch)
+}
+
+func Test_clientIp(t *testing.T) {
+	proxies, err := server.ParseTrustedProxies([]string{"10.0.0.0/8", "2001:db8::1"})
+	require.Nil(t, err)
+	for _, c := range []struct {
+		proxies      server.TrustedProxies
+		remoteAddr   string
+		forwardedFor []string
+		expectedIp   string
+	}{
+		{remoteAddr: "198.51.100.1:5000", expectedIp: "198.51.100.1"},
+		{remoteAddr: "198.51.100.1:5000", forwardedFor: []string{"203.0.113.7"}, expectedIp: "198.51.100.1"},
+		{remoteAddr: "[2001:db8::5]:5000", expectedIp: "2001:db8::5"},
+		{remoteAddr: "[2001:db8::6]:5000", expectedIp: "2001:db8::6"},
+		{remoteAddr: "[2001:db8:0::7]:5000", expectedIp: "2001:db8::7"},
+		{proxies: proxies, remoteAddr: "198.51.100.1:5000", forwardedFor: []string{"203.0.113.7"}, expectedIp: "198.51.100.1"},
+		{proxies: proxies, remoteAddr: "10.0.0.1:5000", expectedIp: "10.0.0.1"},
+		{proxies: proxies, remoteAddr: "10.0.0.1:5000", forwardedFor: []string{"203.0.113.7, 198.51.100.2"}, expectedIp: "198.51.100.2"},
+		{proxies: proxies, remoteAddr: "10.0.0.1:5000", forwardedFor: []string{"203.0.113.7", "198.51.100.2, 10.0.0.2"}, expectedIp: "198.51.100.2"},
+		{proxies: proxies, remoteAddr: "[2001:db8::1]:5000", forwardedFor: []string{"2001:db8::9"}, expectedIp: "2001:db8::9"},
+		{proxies: proxies, remoteAddr: "10.0.0.1:5000", forwardedFor: []string{"10.0.0.3, 10.0.0.2"}, expectedIp: "10.0.0.3"},
+		{proxies: proxies, remoteAddr: "10.0.0.1:5000", forwardedFor: []string{"203.0.113.7, unknown"}, expectedIp: "10.0.0.1"},
+	} {
+		// When
+		r := httptest.NewRequest("GET", "/languages", nil)
+		r.RemoteAddr = c.remoteAddr
+		for _, value := range c.forwardedFor {
+			r.Header.Add("X-Forwarded-For", value)
+		}
+		// Then
+		require.Equal(t, c.expectedIp, c.proxies.ClientIp(r))
+		if len(c.proxies) == 0 {
+			require.Equal(t, c.expectedIp, server.GetIP(r))
+		}
+	}
+
+	// When
+	_, err = server.ParseTrustedProxies([]string{"10.0.0.300"})
+	// Then
+	require.NotNil(t, err)
+}
+
+func Test_replayProtection(t *testing.T) {
+	s, dbAccessor, cl, nodeClient := startTestServerWithConfig(config.ServerConfig{Port: port}, testEngineConfig{
+		scoring:       db.Scoring{ConfirmedRate: 3},
+		requestWindow: time.Hour,
+	})
+	defer s.Stop()
+	nodeClient.IdentitiesByAddr["address1"] = node.Identity{State: "Verified"}
+	nodeClient.IdentitiesByAddr["address2"] = node.Identity{State: "Verified"}
+	now := time.Now().UTC()
+	submit := func(timestamp time.Time) int64 {
+		res, err := cl.Translation.SubmitTranslation(&translation.SubmitTranslationParams{
+			Translation: signedSubmitTransactionRequest(&models.SubmitTranslationRequest{
+				Word: 1, Language: "id", Name: "name", Description: "description", Timestamp: timestamp.Format(time.RFC3339),
+			}, "address1", nodeClient.AddressesByValueAndSignature),
+			Context: context.Background(),
+		})
+		require.Nil(t, err)
+		return res.GetPayload().ResCode
+	}
+	vote := func(up bool, timestamp time.Time) int64 {
+		res, err := cl.Translation.Vote(&translation.VoteParams{
+			Vote: signedVoteRequest(&models.VoteRequest{
+				TranslationID: "1", Up: up, Timestamp: timestamp.Format(time.RFC3339),
+			}, "address2", nodeClient.AddressesByValueAndSignature),
+			Context: context.Background(),
+		})
+		require.Nil(t, err)
+		return res.GetPayload().ResCode
+	}
+
+	// Then
+	require.Equal(t, int64(types.TimestampOutOfWindowError.Code()), submit(now.Add(-time.Hour*2)))
+	require.Equal(t, int64(types.TimestampOutOfWindowError.Code()), submit(now.Add(time.Hour*2)))
+	require.Equal(t, int64(types.SuccessResCode), submit(now))
+	require.Equal(t, int64(types.ReplayedRequestError.Code()), submit(now))
+	require.Equal(t, int64(types.SuccessResCode), vote(true, now))
+	require.Equal(t, int64(types.ReplayedRequestError.Code()), vote(true, now))
+	require.Equal(t, int64(types.SuccessResCode), vote(false, now.Add(time.Second)))
+	require.Equal(t, int64(types.TimestampOutOfWindowError.Code()), vote(true, now.Add(-time.Hour*2)))
+	translations, _, err := dbAccessor.GetTranslations(context.Background(), 1, "id", nil, 5, db.Scoring{ConfirmedRate: 3}, "")
+	require.Nil(t, err)
+	require.Equal(t, 0, translations[0].UpVotes)
+	require.Equal(t, 1, translations[0].DownVotes)
+}
+
+func Test_rateLimits(t *testing.T) {
+	s, _, cl, nodeClient := startTestServerWithConfig(config.ServerConfig{Port: port}, testEngineConfig{
+		scoring: db.Scoring{ConfirmedRate: 3},
+		rateLimits: map[string]ratelimit.RouteLimits{
+			"submitTranslation": {Address: db.RateLimit{Rate: 1.0 / 60, Burst: 2}},
+			"vote":              {Ip: db.RateLimit{Rate: 1.0 / 60, Burst: 3}},
+		},
+	})
+	defer s.Stop()
+	for _, address := range []string{"address1", "address2", "address3", "address4", "address5"} {
+		nodeClient.IdentitiesByAddr[address] = node.Identity{State: "Verified"}
+	}
+	submit := func(word uint32, address string) *translation.SubmitTranslationOK {
+		res, err := cl.Translation.SubmitTranslation(&translation.SubmitTranslationParams{
+			Translation: signedSubmitTransactionRequest(&models.SubmitTranslationRequest{
+				Word: int64(word), Language: "id", Name: "name", Description: "description", Timestamp: time.Now().UTC().Format(time.RFC3339),
+			}, address, nodeClient.AddressesByValueAndSignature),
+			Context: context.Background(),
+		})
+		require.Nil(t, err)
+		return res
+	}
+	vote := func(address string) *translation.VoteOK {
+		res, err := cl.Translation.Vote(&translation.VoteParams{
+			Vote: signedVoteRequest(&models.VoteRequest{
+				TranslationID: "1", Up: true, Timestamp: time.Now().UTC().Format(time.RFC3339),
+			}, address, nodeClient.AddressesByValueAndSignature),
+			Context: context.Background(),
+		})
+		require.Nil(t, err)
+		return res
+	}
+
+	// Then
+	require.Equal(t, int64(types.SuccessResCode), submit(1, "address1").GetPayload().ResCode)
+	require.Equal(t, int64(types.SuccessResCode), submit(2, "address1").GetPayload().ResCode)
+	limitedSubmit := submit(3, "address1")
+	require.Equal(t, int64(types.RateLimitedError.Code()), limitedSubmit.GetPayload().ResCode)
+	require.Equal(t, types.RateLimitedError.Error(), limitedSubmit.GetPayload().Error)
+	require.True(t, limitedSubmit.RetryAfter > 0 && limitedSubmit.RetryAfter <= 60)
+	require.Equal(t, limitedSubmit.RetryAfter, limitedSubmit.GetPayload().RetryAfter)
+	require.Equal(t, int64(types.RateLimitedError.Code()), submit(3, "ADDRESS1").GetPayload().ResCode)
+	require.Equal(t, int64(types.SuccessResCode), submit(3, "address2").GetPayload().ResCode)
+	require.Equal(t, int64(0), submit(4, "address2").RetryAfter)
+
+	// Votes are limited by ip, so other addresses are limited as well
+	require.Equal(t, int64(types.SuccessResCode), vote("address3").GetPayload().ResCode)
+	require.Equal(t, int64(types.SuccessResCode), vote("address4").GetPayload().ResCode)
+	require.Equal(t, int64(types.SuccessResCode), vote("address5").GetPayload().ResCode)
+	limitedVote := vote("address3")
+	require.Equal(t, int64(types.RateLimitedError.Code()), limitedVote.GetPayload().ResCode)
+	require.True(t, limitedVote.RetryAfter > 0 && limitedVote.RetryAfter <= 60)
+
+	// X-Forwarded-For is ignored without trusted proxies
+	body, err := json.Marshal(signedVoteRequest(&models.VoteRequest{
+		TranslationID: "1", Up: true, Timestamp: time.Now().UTC().Format(time.RFC3339),
+	}, "address4", nodeClient.AddressesByValueAndSignature))
+	require.Nil(t, err)
+	req, err := http.NewRequest("POST", fmt.Sprintf("http://localhost:%v/vote", port), strings.NewReader(string(body)))
+	require.Nil(t, err)
+	req.Header.Set("X-Forwarded-For", "203.0.113.7")
+	resp, err := http.DefaultClient.Do(req)
+	require.Nil(t, err)
+	defer resp.Body.Close()
+	var spoofedVote types.VoteResponse
+	require.Nil(t, json.NewDecoder(resp.Body).Decode(&spoofedVote))
+	require.Equal(t, types.RateLimitedError.Code(), spoofedVote.ResCode)
+
+	// Buckets are refilled over time
+	store := memory.NewRateLimitStore()
+	limit := db.RateLimit{Rate: 2, Burst: 1}
+	now := time.Now()
+	for _, step := range []struct {
+		at         time.Duration
+		retryAfter time.Duration
+	}{
+		{0, 0},
+		{0, time.Millisecond * 500},
+		{time.Millisecond * 250, time.Millisecond * 250},
+		{time.Millisecond * 500, 0},
+		{time.Second * 10, 0},
+		{time.Second * 10, time.Millisecond * 500},
+	} {
+		// When
+		retryAfter, err := store.TakeRateLimitToken(context.Background(), "key", limit, now.Add(step.at))
+		// Then
+		require.Nil(t, err)
+		require.InDelta(t, float64(step.retryAfter), float64(retryAfter), float64(time.Millisecond))
+	}
+}
+
+func Test_confirmedTranslationsByWords(t *testing.T) {
+	// Word 2 duplicates word 0, so it has the same translations
+	wordsServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"words":[{"name":"w0","desc":"d0"},{"name":"w1","desc":"d1"},{"name":"w0","desc":"d0"}]}`)
+	}))
+	defer wordsServer.Close()
+	s, _, cl, nodeClient := startTestServerWithConfig(config.ServerConfig{Port: port}, testEngineConfig{
+		scoring:  db.Scoring{ConfirmedRate: 1},
+		wordsUrl: wordsServer.URL,
+	})
+	defer s.Stop()
+	for _, address := range []string{"address1", "address2", "address3"} {
+		nodeClient.IdentitiesByAddr[address] = node.Identity{State: "Verified"}
+	}
+	submit := func(word uint32, language, name, address string) string {
+		res, err := cl.Translation.SubmitTranslation(&translation.SubmitTranslationParams{
+			Translation: signedSubmitTransactionRequest(&models.SubmitTranslationRequest{
+				Word: int64(word), Language: language, Name: name, Timestamp: time.Now().UTC().Format(time.RFC3339),
+			}, address, nodeClient.AddressesByValueAndSignature),
+			Context: context.Background(),
+		})
+		require.Nil(t, err)
+		require.Equal(t, int64(types.SuccessResCode), res.GetPayload().ResCode)
+		return res.GetPayload().TranslationID
+	}
+	voteUp := func(translationId string) {
+		res, err := cl.Translation.Vote(&translation.VoteParams{
+			Vote: signedVoteRequest(&models.VoteRequest{
+				TranslationID: translationId, Up: true, Timestamp: time.Now().UTC().Format(time.RFC3339),
+			}, "address3", nodeClient.AddressesByValueAndSignature),
+			Context: context.Background(),
+		})
+		require.Nil(t, err)
+		require.Equal(t, int64(types.SuccessResCode), res.GetPayload().ResCode)
+	}
+	idTranslationId := submit(0, "id", "name-id", "address1")
+	voteUp(idTranslationId)
+	submit(1, "id", "unconfirmed", "address1")
+	frTranslationId := submit(0, "fr", "name-fr", "address2")
+	voteUp(frTranslationId)
+
+	// When
+	res, err := cl.Translation.GetConfirmedTranslationsByWords(&translation.GetConfirmedTranslationsByWordsParams{
+		Request: &models.GetConfirmedTranslationsByWordsRequest{Words: []int64{0, 1, 2}, Languages: []string{"id", "FR", "de"}},
+		Context: context.Background(),
+	})
+	// Then
+	require.Nil(t, err)
+	translations := res.GetPayload().Translations
+	require.Len(t, translations, 3)
+	require.Len(t, translations["id"], 3)
+	require.Equal(t, idTranslationId, translations["id"]["0"].ID)
+	require.Equal(t, "name-id", translations["id"]["0"].Name)
+	require.True(t, translations["id"]["0"].Confirmed)
+	require.Nil(t, translations["id"]["1"])
+	require.Equal(t, idTranslationId, translations["id"]["2"].ID)
+	require.Equal(t, frTranslationId, translations["fr"]["0"].ID)
+	require.Nil(t, translations["fr"]["1"])
+	require.Equal(t, frTranslationId, translations["fr"]["2"].ID)
+	require.Equal(t, map[string]*models.Translation{"0": nil, "1": nil, "2": nil}, translations["de"])
+
+	// When
+	_, err = cl.Translation.GetConfirmedTranslationsByWords(&translation.GetConfirmedTranslationsByWordsParams{
+		Request: &models.GetConfirmedTranslationsByWordsRequest{Languages: []string{"id"}},
+		Context: context.Background(),
+	})
+	// Then
+	require.IsType(t, &translation.GetConfirmedTranslationsByWordsBadRequest{}, err)
+
+	// When
+	_, err = cl.Translation.GetConfirmedTranslationsByWords(&translation.GetConfirmedTranslationsByWordsParams{
+		Request: &models.GetConfirmedTranslationsByWordsRequest{Words: []int64{1}, Languages: []string{"not a language"}},
+		Context: context.Background(),
+	})
+	// Then
+	require.IsType(t, &translation.GetConfirmedTranslationsByWordsBadRequest{}, err)
+}
+
+func Test_voteState(t *testing.T) {
+	s, _, cl, nodeClient := startTestServerWithConfig(config.ServerConfig{Port: port}, testEngineConfig{
+		scoring: db.Scoring{ConfirmedRate: 1},
+	})
+	defer s.Stop()
+	for _, address := range []string{"address1", "address2", "address3"} {
+		nodeClient.IdentitiesByAddr[address] = node.Identity{State: "Verified"}
+	}
+	var translationIds []string
+	for _, address := range []string{"address1", "address2"} {
+		res, err := cl.Translation.SubmitTranslation(&translation.SubmitTranslationParams{
+			Translation: signedSubmitTransactionRequest(&models.SubmitTranslationRequest{
+				Word: 1, Language: "id", Name: address, Timestamp: time.Now().UTC().Format(time.RFC3339),
+			}, address, nodeClient.AddressesByValueAndSignature),
+			Context: context.Background(),
+		})
+		require.Nil(t, err)
+		require.Equal(t, int64(types.SuccessResCode), res.GetPayload().ResCode)
+		translationIds = append(translationIds, res.GetPayload().TranslationID)
+	}
+	for i, up := range []bool{true, false} {
+		res, err := cl.Translation.Vote(&translation.VoteParams{
+			Vote: signedVoteRequest(&models.VoteRequest{
+				TranslationID: translationIds[i], Up: up, Timestamp: time.Now().UTC().Format(time.RFC3339),
+			}, "address3", nodeClient.AddressesByValueAndSignature),
+			Context: context.Background(),
+		})
+		require.Nil(t, err)
+		require.Equal(t, int64(types.SuccessResCode), res.GetPayload().ResCode)
+	}
+	getTranslations := func(address *string) []*models.Translation {
+		res, err := cl.Translation.GetTranslations(&translation.GetTranslationsParams{
+			Word: 1, Language: "id", Address: address, Context: context.Background(),
+		})
+		require.Nil(t, err)
+		require.Len(t, res.GetPayload().Translations, 2)
+		return res.GetPayload().Translations
+	}
+	getConfirmedTranslation := func(address *string) *models.Translation {
+		res, err := cl.Translation.GetConfirmedTranslation(&translation.GetConfirmedTranslationParams{
+			Word: 1, Language: "id", Address: address, Context: context.Background(),
+		})
+		require.Nil(t, err)
+		require.NotNil(t, res.GetPayload().Translation)
+		return res.GetPayload().Translation
+	}
+	address1, address3 := "ADDRESS1", "address3"
+
+	// When
+	translations := getTranslations(&address3)
+	// Then
+	require.Equal(t, translationIds[0], translations[0].ID)
+	require.Equal(t, models.TranslationMyVoteUp, translations[0].MyVote)
+	require.False(t, translations[0].IsMine)
+	require.Equal(t, translationIds[1], translations[1].ID)
+	require.Equal(t, models.TranslationMyVoteDown, translations[1].MyVote)
+	require.False(t, translations[1].IsMine)
+
+	// When
+	translations = getTranslations(&address1)
+	// Then
+	require.Equal(t, models.TranslationMyVoteNone, translations[0].MyVote)
+	require.True(t, translations[0].IsMine)
+	require.Equal(t, models.TranslationMyVoteNone, translations[1].MyVote)
+	require.False(t, translations[1].IsMine)
+
+	// When
+	translations = getTranslations(nil)
+	// Then
+	for _, item := range translations {
+		require.Empty(t, item.MyVote)
+		require.False(t, item.IsMine)
+	}
+
+	// When
+	confirmed := getConfirmedTranslation(&address3)
+	// Then
+	require.Equal(t, translationIds[0], confirmed.ID)
+	require.Equal(t, models.TranslationMyVoteUp, confirmed.MyVote)
+	require.False(t, confirmed.IsMine)
+
+	// When
+	confirmed = getConfirmedTranslation(&address1)
+	// Then
+	require.Equal(t, models.TranslationMyVoteNone, confirmed.MyVote)
+	require.True(t, confirmed.IsMine)
+
+	// When
+	confirmed = getConfirmedTranslation(nil)
+	// Then
+	require.Empty(t, confirmed.MyVote)
+	require.False(t, confirmed.IsMine)
+}
+
+func Test_concurrentVoteRetraction(t *testing.T) {
+	s, dbAccessor, _, _ := startTestServer()
+	defer s.Stop()
+	scoring := db.Scoring{ConfirmedRate: 3}
+	now := time.Now()
+	translationId, err := dbAccessor.SubmitTranslation(context.Background(), "author", 1, "id", "name", "description", now, scoring)
+	require.Nil(t, err)
+	var voters []string
+	for i := 0; i < 20; i++ {
+		voter := fmt.Sprintf("voter%v", i)
+		voters = append(voters, voter)
+		_, err := dbAccessor.Vote(context.Background(), voter, *translationId, true, 1, now)
+		require.Nil(t, err)
+	}
+
+	// When
+	var wg sync.WaitGroup
+	for i, voter := range voters {
+		wg.Add(2)
+		// The order of the timestamps alternates, so either the vote change or the retraction is outdated
+		voteTimestamp, retractTimestamp := now.Add(time.Second), now.Add(time.Second*2)
+		if i%2 == 0 {
+			voteTimestamp, retractTimestamp = retractTimestamp, voteTimestamp
+		}
+		go func(voter string) {
+			defer wg.Done()
+			_, _ = dbAccessor.Vote(context.Background(), voter, *translationId, false, 1, voteTimestamp)
+		}(voter)
+		go func(voter string) {
+			defer wg.Done()
+			_, _ = dbAccessor.RetractVote(context.Background(), voter, *translationId, retractTimestamp)
+		}(voter)
+	}
+	wg.Wait()
+
+	// Then
+	var upVotes, downVotes int
+	for _, voter := range voters {
+		votes, _, err := dbAccessor.GetAddressVotes(context.Background(), voter, 0, 5, scoring)
+		require.Nil(t, err)
+		for _, vote := range votes {
+			if vote.Up {
+				upVotes++
+			} else {
+				downVotes++
+			}
+		}
+	}
+	translations, _, err := dbAccessor.GetTranslations(context.Background(), 1, "id", nil, 5, scoring, "")
+	require.Nil(t, err)
+	require.Len(t, translations, 1)
+	require.Equal(t, upVotes, translations[0].UpVotes)
+	require.Equal(t, downVotes, translations[0].DownVotes)
+	require.Equal(t, float64(upVotes), translations[0].WeightedUpVotes)
+	require.Equal(t, float64(downVotes), translations[0].WeightedDownVotes)
+}
+
+func Test_retractVote(t *testing.T) {
+	s, _, cl, nodeClient := startTestServerWithConfig(config.ServerConfig{Port: port}, testEngineConfig{
+		scoring: db.Scoring{ConfirmedRate: 1},
+	})
+	defer s.Stop()
+	for _, address := range []string{"address1", "address2", "address3"} {
+		nodeClient.IdentitiesByAddr[address] = node.Identity{State: "Verified"}
+	}
+	submitRes, err := cl.Translation.SubmitTranslation(&translation.SubmitTranslationParams{
+		Translation: signedSubmitTransactionRequest(&models.SubmitTranslationRequest{
+			Word: 1, Language: "id", Name: "name", Timestamp: time.Now().UTC().Format(time.RFC3339),
+		}, "address1", nodeClient.AddressesByValueAndSignature),
+		Context: context.Background(),
+	})
+	require.Nil(t, err)
+	translationId := submitRes.GetPayload().TranslationID
+	now := time.Now().UTC()
+	vote := func(up bool, timestamp time.Time, address string) *models.VoteResponse {
+		res, err := cl.Translation.Vote(&translation.VoteParams{
+			Vote: signedVoteRequest(&models.VoteRequest{
+				TranslationID: translationId, Up: up, Timestamp: timestamp.Format(time.RFC3339),
+			}, address, nodeClient.AddressesByValueAndSignature),
+			Context: context.Background(),
+		})
+		require.Nil(t, err)
+		return res.GetPayload()
+	}
+	retract := func(translationId string, timestamp time.Time, address string) (*models.RetractVoteResponse, error) {
+		res, err := cl.Translation.RetractVote(&translation.RetractVoteParams{
+			Retraction: signedRetractVoteRequest(&models.RetractVoteRequest{
+				TranslationID: translationId, Timestamp: timestamp.Format(time.RFC3339), Version: 2,
+			}, address, nodeClient.AddressesByValueAndSignature),
+			Context: context.Background(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return res.GetPayload(), nil
+	}
+	require.Equal(t, int64(types.SuccessResCode), vote(true, now.Add(-time.Second*10), "address2").ResCode)
+	require.Equal(t, int64(types.SuccessResCode), vote(false, now.Add(-time.Second*10), "address3").ResCode)
+
+	// When
+	retractRes, err := retract(translationId, now.Add(-time.Second*10), "address2")
+	// Then
+	require.Nil(t, err)
+	require.Equal(t, int64(types.OutdatedSubmissionError.Code()), retractRes.ResCode)
+
+	// When
+	retractRes, err = retract(translationId, now, "address2")
+	// Then
+	require.Nil(t, err)
+	require.Equal(t, int64(types.SuccessResCode), retractRes.ResCode)
+	require.Zero(t, retractRes.UpVotes)
+	require.Zero(t, retractRes.WeightedUpVotes)
+	require.Equal(t, int64(1), retractRes.DownVotes)
+	require.Equal(t, float64(1), retractRes.WeightedDownVotes)
+	address2 := "address2"
+	listRes, err := cl.Translation.GetTranslations(&translation.GetTranslationsParams{
+		Word: 1, Language: "id", Address: &address2, Context: context.Background(),
+	})
+	require.Nil(t, err)
+	require.Len(t, listRes.GetPayload().Translations, 1)
+	require.Zero(t, listRes.GetPayload().Translations[0].UpVotes)
+	require.Equal(t, models.TranslationMyVoteNone, listRes.GetPayload().Translations[0].MyVote)
+	votesRes, err := cl.Translation.GetAddressVotes(&translation.GetAddressVotesParams{
+		Address: "address2", Context: context.Background(),
+	})
+	require.Nil(t, err)
+	require.Empty(t, votesRes.GetPayload().Votes)
+
+	// When
+	retractRes, err = retract(translationId, now.Add(time.Second), "address2")
+	// Then
+	require.Nil(t, err)
+	require.Equal(t, int64(types.NoVoteError.Code()), retractRes.ResCode)
+
+	// Votes signed before the retraction are outdated
+	for _, timestamp := range []time.Time{now, now.Add(-time.Second * 5)} {
+		// When
+		voteRes := vote(true, timestamp, "address2")
+		// Then
+		require.Equal(t, int64(types.OutdatedSubmissionError.Code()), voteRes.ResCode)
+	}
+
+	// When
+	voteRes := vote(true, now.Add(time.Second*5), "address2")
+	// Then
+	require.Equal(t, int64(types.SuccessResCode), voteRes.ResCode)
+	require.Equal(t, int64(1), voteRes.UpVotes)
+	require.Equal(t, int64(1), voteRes.DownVotes)
+
+	// When
+	retractRes, err = retract(translationId, now, "address3")
+	// Then
+	require.Nil(t, err)
+	require.Equal(t, int64(types.SuccessResCode), retractRes.ResCode)
+	require.Equal(t, int64(1), retractRes.UpVotes)
+	require.Zero(t, retractRes.DownVotes)
+	require.Zero(t, retractRes.WeightedDownVotes)
+	confirmedRes, err := cl.Translation.GetConfirmedTranslation(&translation.GetConfirmedTranslationParams{
+		Word: 1, Language: "id", Context: context.Background(),
+	})
+	require.Nil(t, err)
+	require.NotNil(t, confirmedRes.GetPayload().Translation)
+
+	// When
+	retractRes, err = retract(translationId, now, "address1")
+	// Then
+	require.Nil(t, err)
+	require.Equal(t, int64(types.NoVoteError.Code()), retractRes.ResCode)
+
+	// When
+	_, err = retract("999", now, "address2")
+	// Then
+	require.IsType(t, &translation.RetractVoteBadRequest{}, err)
+
+	// When
+	_, err = cl.Translation.RetractVote(&translation.RetractVoteParams{
+		Retraction: &models.RetractVoteRequest{TranslationID: translationId, Timestamp: now.Format(time.RFC3339)},
+		Context:    context.Background(),
+	})
+	// Then
+	require.IsType(t, &translation.RetractVoteBadRequest{}, err)
+}
+
+func Test_addressContributions(t *testing.T) {
+	s, _, cl, nodeClient := startTestServerWithConfig(config.ServerConfig{Port: port}, testEngineConfig{
+		scoring: db.Scoring{ConfirmedRate: 1},
+	})
+	defer s.Stop()
+	for _, address := range []string{"address1", "address2"} {
+		nodeClient.IdentitiesByAddr[address] = node.Identity{State: "Verified"}
+	}
+	submit := func(word uint32, address string) string {
+		res, err := cl.Translation.SubmitTranslation(&translation.SubmitTranslationParams{
+			Translation: signedSubmitTransactionRequest(&models.SubmitTranslationRequest{
+				Word: int64(word), Language: "id", Name: fmt.Sprintf("name%v", word), Timestamp: time.Now().UTC().Format(time.RFC3339),
+			}, address, nodeClient.AddressesByValueAndSignature),
+			Context: context.Background(),
+		})
+		require.Nil(t, err)
+		require.Equal(t, int64(types.SuccessResCode), res.GetPayload().ResCode)
+		return res.GetPayload().TranslationID
+	}
+	vote := func(translationId string, up bool, address string) {
+		res, err := cl.Translation.Vote(&translation.VoteParams{
+			Vote: signedVoteRequest(&models.VoteRequest{
+				TranslationID: translationId, Up: up, Timestamp: time.Now().UTC().Format(time.RFC3339),
+			}, address, nodeClient.AddressesByValueAndSignature),
+			Context: context.Background(),
+		})
+		require.Nil(t, err)
+		require.Equal(t, int64(types.SuccessResCode), res.GetPayload().ResCode)
+	}
+	var translationIds []string
+	for word := uint32(1); word <= 6; word++ {
+		translationId := submit(word, "address1")
+		translationIds = append(translationIds, translationId)
+		vote(translationId, word%2 == 0, "address2")
+	}
+	otherTranslationId := submit(1, "address2")
+	vote(otherTranslationId, false, "address1")
+
+	// When
+	translationsRes, err := cl.Translation.GetAddressTranslations(&translation.GetAddressTranslationsParams{
+		Address: "ADDRESS1", Context: context.Background(),
+	})
+	// Then
+	require.Nil(t, err)
+	require.NotEmpty(t, translationsRes.ContinuationToken)
+	page := translationsRes.GetPayload().Translations
+	require.Len(t, page, 5)
+	require.Equal(t, translationIds[5], page[0].ID)
+	require.Equal(t, int64(6), page[0].Word)
+	require.Equal(t, "id", page[0].Language)
+	require.Equal(t, "name6", page[0].Name)
+	require.Equal(t, int64(1), page[0].UpVotes)
+	require.True(t, page[0].Confirmed)
+	require.Equal(t, models.ContributedTranslationStatusVisible, page[0].Status)
+	require.NotEmpty(t, page[0].Timestamp)
+	require.Equal(t, translationIds[1], page[4].ID)
+	require.Equal(t, int64(1), page[4].DownVotes+page[4].UpVotes)
+	translationsToken := translationsRes.ContinuationToken
+
+	// When
+	translationsRes, err = cl.Translation.GetAddressTranslations(&translation.GetAddressTranslationsParams{
+		Address: "address1", ContinuationToken: &translationsToken, Context: context.Background(),
+	})
+	// Then
+	require.Nil(t, err)
+	require.Empty(t, translationsRes.ContinuationToken)
+	require.Len(t, translationsRes.GetPayload().Translations, 1)
+	require.Equal(t, translationIds[0], translationsRes.GetPayload().Translations[0].ID)
+	require.False(t, translationsRes.GetPayload().Translations[0].Confirmed)
+
+	// When
+	votesRes, err := cl.Translation.GetAddressVotes(&translation.GetAddressVotesParams{
+		Address: "address2", Context: context.Background(),
+	})
+	// Then
+	require.Nil(t, err)
+	require.NotEmpty(t, votesRes.ContinuationToken)
+	votes := votesRes.GetPayload().Votes
+	require.Len(t, votes, 5)
+	require.True(t, votes[0].Up)
+	require.Equal(t, float64(1), votes[0].Weight)
+	require.False(t, votes[0].Excluded)
+	require.NotEmpty(t, votes[0].Timestamp)
+	require.Equal(t, translationIds[5], votes[0].Translation.ID)
+	require.Equal(t, "name6", votes[0].Translation.Name)
+	require.False(t, votes[1].Up)
+	require.Equal(t, translationIds[4], votes[1].Translation.ID)
+
+	// Tokens are bound to the address and to the list
+	for _, params := range []*translation.GetAddressVotesParams{
+		{Address: "address1", ContinuationToken: &votesRes.ContinuationToken},
+		{Address: "address1", ContinuationToken: &translationsToken},
+	} {
+		_, err = cl.Translation.GetAddressVotes(params.WithContext(context.Background()))
+		require.IsType(t, &translation.GetAddressVotesBadRequest{}, err)
+	}
+
+	// When
+	votesRes, err = cl.Translation.GetAddressVotes(&translation.GetAddressVotesParams{
+		Address: "address2", ContinuationToken: &votesRes.ContinuationToken, Context: context.Background(),
+	})
+	// Then
+	require.Nil(t, err)
+	require.Empty(t, votesRes.ContinuationToken)
+	require.Len(t, votesRes.GetPayload().Votes, 1)
+	require.Equal(t, translationIds[0], votesRes.GetPayload().Votes[0].Translation.ID)
+
+	// When
+	votesRes, err = cl.Translation.GetAddressVotes(&translation.GetAddressVotesParams{
+		Address: "address1", Context: context.Background(),
+	})
+	// Then
+	require.Nil(t, err)
+	require.Len(t, votesRes.GetPayload().Votes, 1)
+	require.False(t, votesRes.GetPayload().Votes[0].Up)
+	require.Equal(t, otherTranslationId, votesRes.GetPayload().Votes[0].Translation.ID)
+	require.Equal(t, int64(1), votesRes.GetPayload().Votes[0].Translation.DownVotes)
+
+	// When
+	translationsRes, err = cl.Translation.GetAddressTranslations(&translation.GetAddressTranslationsParams{
+		Address: "address3", Context: context.Background(),
+	})
+	// Then
+	require.Nil(t, err)
+	require.NotNil(t, translationsRes.GetPayload().Translations)
+	require.Empty(t, translationsRes.GetPayload().Translations)
+}
+
+func Test_hiddenContributions(t *testing.T) {
+	s, _, cl, nodeClient := startTestServerWithConfig(config.ServerConfig{Port: port}, testEngineConfig{
+		scoring:       db.Scoring{ConfirmedRate: 1},
+		hideThreshold: 2,
+	})
+	defer s.Stop()
+	for _, address := range []string{"address1", "address2", "address3", "address4"} {
+		nodeClient.IdentitiesByAddr[address] = node.Identity{State: "Verified"}
+	}
+	submitRes, err := cl.Translation.SubmitTranslation(&translation.SubmitTranslationParams{
+		Translation: signedSubmitTransactionRequest(&models.SubmitTranslationRequest{
+			Word: 1, Language: "id", Name: "name", Description: "description", Timestamp: time.Now().UTC().Format(time.RFC3339),
+		}, "address1", nodeClient.AddressesByValueAndSignature),
+		Context: context.Background(),
+	})
+	require.Nil(t, err)
+	translationId := submitRes.GetPayload().TranslationID
+	voteRes, err := cl.Translation.Vote(&translation.VoteParams{
+		Vote: signedVoteRequest(&models.VoteRequest{
+			TranslationID: translationId, Up: true, Timestamp: time.Now().UTC().Format(time.RFC3339),
+		}, "address4", nodeClient.AddressesByValueAndSignature),
+		Context: context.Background(),
+	})
+	require.Nil(t, err)
+	require.Equal(t, int64(types.SuccessResCode), voteRes.GetPayload().ResCode)
+	contributions := func() (*models.ContributedTranslation, *models.ContributedTranslation) {
+		translationsRes, err := cl.Translation.GetAddressTranslations(&translation.GetAddressTranslationsParams{
+			Address: "address1", Context: context.Background(),
+		})
+		require.Nil(t, err)
+		require.Len(t, translationsRes.GetPayload().Translations, 1)
+		votesRes, err := cl.Translation.GetAddressVotes(&translation.GetAddressVotesParams{
+			Address: "address4", Context: context.Background(),
+		})
+		require.Nil(t, err)
+		require.Len(t, votesRes.GetPayload().Votes, 1)
+		return translationsRes.GetPayload().Translations[0], votesRes.GetPayload().Votes[0].Translation
+	}
+	authored, voted := contributions()
+	for _, contributed := range []*models.ContributedTranslation{authored, voted} {
+		require.Equal(t, "name", contributed.Name)
+		require.Equal(t, "description", contributed.Description)
+		require.Equal(t, models.ContributedTranslationStatusVisible, contributed.Status)
+	}
+
+	// When
+	var hidden bool
+	for _, address := range []string{"address2", "address3"} {
+		res, err := cl.Translation.Report(&translation.ReportParams{
+			Report: signedReportRequest(&models.ReportRequest{
+				TranslationID: translationId, Reason: models.ReportRequestReasonOffensive, Timestamp: time.Now().UTC().Format(time.RFC3339), Version: 2,
+			}, address, nodeClient.AddressesByValueAndSignature),
+			Context: context.Background(),
+		})
+		require.Nil(t, err)
+		require.Equal(t, int64(types.SuccessResCode), res.GetPayload().ResCode)
+		hidden = res.GetPayload().Hidden
+	}
+	// Then
+	require.True(t, hidden)
+	authored, voted = contributions()
+	for _, contributed := range []*models.ContributedTranslation{authored, voted} {
+		require.Equal(t, translationId, contributed.ID)
+		require.Empty(t, contributed.Name)
+		require.Empty(t, contributed.Description)
+		require.Equal(t, models.ContributedTranslationStatusHidden, contributed.Status)
+	}
+}
+
+func Test_moderation(t *testing.T) {
+	const moderatorApiKey = "moderatorKey"
+	s, _, cl, nodeClient := startTestServerWithConfig(config.ServerConfig{Port: port, AdminApiKey: adminApiKey, ModeratorApiKey: moderatorApiKey}, testEngineConfig{
+		scoring:       db.Scoring{ConfirmedRate: 1},
+		hideThreshold: 2,
+	})
+	defer s.Stop()
+	for _, address := range []string{"address1", "address2", "address3", "address4"} {
+		nodeClient.IdentitiesByAddr[address] = node.Identity{State: "Verified"}
+	}
+	submitRes, err := cl.Translation.SubmitTranslation(&translation.SubmitTranslationParams{
+		Translation: signedSubmitTransactionRequest(&models.SubmitTranslationRequest{
+			Word: 1, Language: "id", Name: "name", Description: "description", Timestamp: time.Now().UTC().Format(time.RFC3339),
+		}, "address1", nodeClient.AddressesByValueAndSignature),
+		Context: context.Background(),
+	})
+	require.Nil(t, err)
+	translationId := submitRes.GetPayload().TranslationID
+	voteRes, err := cl.Translation.Vote(&translation.VoteParams{
+		Vote: signedVoteRequest(&models.VoteRequest{
+			TranslationID: translationId, Up: true, Timestamp: time.Now().UTC().Format(time.RFC3339),
+		}, "address4", nodeClient.AddressesByValueAndSignature),
+		Context: context.Background(),
+	})
+	require.Nil(t, err)
+	require.Equal(t, int64(types.SuccessResCode), voteRes.GetPayload().ResCode)
+	report := func(address, reason string) (*translation.ReportOK, error) {
+		return cl.Translation.Report(&translation.ReportParams{
+			Report: signedReportRequest(&models.ReportRequest{
+				TranslationID: translationId, Reason: reason, Timestamp: time.Now().UTC().Format(time.RFC3339), Version: 2,
+			}, address, nodeClient.AddressesByValueAndSignature),
+			Context: context.Background(),
+		})
+	}
+	translationsCount := func() int {
+		res, err := cl.Translation.GetTranslations(&translation.GetTranslationsParams{
+			Word: 1, Language: "id", Context: context.Background(),
+		})
+		require.Nil(t, err)
+		return len(res.GetPayload().Translations)
+	}
+	confirmedTranslation := func() *models.Translation {
+		res, err := cl.Translation.GetConfirmedTranslation(&translation.GetConfirmedTranslationParams{
+			Word: 1, Language: "id", Context: context.Background(),
+		})
+		require.Nil(t, err)
+		return res.GetPayload().Translation
+	}
+
+	// When
+	res, err := report("address1", models.ReportRequestReasonSpam)
+	// Then
+	require.Nil(t, err)
+	require.Equal(t, int64(types.SelfReportingError.Code()), res.GetPayload().ResCode)
+
+	// When
+	res, err = report("address5", models.ReportRequestReasonSpam)
+	// Then
+	require.Nil(t, err)
+	require.Equal(t, int64(types.NotIdentityError.Code()), res.GetPayload().ResCode)
+
+	// When
+	res, err = report("address2", models.ReportRequestReasonSpam)
+	// Then
+	require.Nil(t, err)
+	require.Equal(t, int64(types.SuccessResCode), res.GetPayload().ResCode)
+	require.False(t, res.GetPayload().Hidden)
+	require.Equal(t, 1, translationsCount())
+
+	// When
+	res, err = report("address2", models.ReportRequestReasonOffensive)
+	// Then
+	require.Nil(t, err)
+	require.Equal(t, int64(types.DuplicatedReportError.Code()), res.GetPayload().ResCode)
+
+	// When
+	res, err = report("address3", models.ReportRequestReasonOffensive)
+	// Then
+	require.Nil(t, err)
+	require.Equal(t, int64(types.SuccessResCode), res.GetPayload().ResCode)
+	require.True(t, res.GetPayload().Hidden)
+	require.Equal(t, 0, translationsCount())
+	require.Nil(t, confirmedTranslation())
+
+	// When
+	_, err = cl.Translation.GetModerationQueue(&translation.GetModerationQueueParams{
+		APIKey: "wrongKey", Context: context.Background(),
+	})
+	// Then
+	require.IsType(t, &translation.GetModerationQueueForbidden{}, err)
+
+	// When
+	queueRes, err := cl.Translation.GetModerationQueue(&translation.GetModerationQueueParams{
+		APIKey: moderatorApiKey, Context: context.Background(),
+	})
+	// Then
+	require.Nil(t, err)
+	require.Equal(t, []*models.ModeratedTranslation{
+		{ID: translationId, Word: 1, Language: "id", Address: "address1", Name: "name", Description: "description",
+			Status: models.ModeratedTranslationStatusHidden, Reports: []*models.ReportCount{
+				{Reason: models.ReportRequestReasonOffensive, Count: 1},
+				{Reason: models.ReportRequestReasonSpam, Count: 1},
+			}},
+	}, queueRes.GetPayload().Translations)
+
+	// When
+	restoreRes, err := cl.Translation.RestoreTranslation(&translation.RestoreTranslationParams{
+		APIKey: moderatorApiKey, ID: translationId, Context: context.Background(),
+	})
+	// Then
+	require.Nil(t, err)
+	require.Equal(t, models.ModeratedTranslationStatusVisible, restoreRes.GetPayload().Status)
+	require.Equal(t, 1, translationsCount())
+	require.NotNil(t, confirmedTranslation())
+	queueRes, err = cl.Translation.GetModerationQueue(&translation.GetModerationQueueParams{
+		APIKey: adminApiKey, Context: context.Background(),
+	})
+	require.Nil(t, err)
+	require.Empty(t, queueRes.GetPayload().Translations)
+
+	// Resolved reports are not counted, the address can report the translation again
+	res, err = report("address2", models.ReportRequestReasonIncorrect)
+	require.Nil(t, err)
+	require.Equal(t, int64(types.SuccessResCode), res.GetPayload().ResCode)
+	require.False(t, res.GetPayload().Hidden)
+
+	// When
+	removeRes, err := cl.Translation.RemoveTranslation(&translation.RemoveTranslationParams{
+		APIKey: adminApiKey, ID: translationId, Context: context.Background(),
+	})
+	// Then
+	require.Nil(t, err)
+	require.Equal(t, models.ModeratedTranslationStatusRemoved, removeRes.GetPayload().Status)
+	require.Equal(t, []*models.ReportCount{{Reason: models.ReportRequestReasonIncorrect, Count: 1}}, removeRes.GetPayload().Reports)
+	require.Equal(t, 0, translationsCount())
+
+	// When
+	_, err = report("address3", models.ReportRequestReasonSpam)
+	// Then
+	require.IsType(t, &translation.ReportBadRequest{}, err)
+
+	// When
+	_, err = cl.Translation.RestoreTranslation(&translation.RestoreTranslationParams{
+		APIKey: moderatorApiKey, ID: translationId, Context: context.Background(),
+	})
+	// Then
+	require.IsType(t, &translation.RestoreTranslationBadRequest{}, err)
+}
+
+// Signed values of version 2 are fixed, clients build exactly the same bytes
+var submitTranslationPayloadVectors = []struct {
+	format  signing.Format
+	request types.SubmitTranslationRequest
+	value   string
+}{
+	{
+		format:  signing.Format{Network: "mainnet"},
+		request: types.SubmitTranslationRequest{Word: 1, Language: "id", Name: "name", Description: "desc", Timestamp: "2020-01-01T00:00:00Z", Version: 2},
+		value:   "17:idena-translation1:27:mainnet17:submitTranslation1:12:id4:name4:desc20:2020-01-01T00:00:00Z",
+	},
+	{
+		format:  signing.Format{Network: "testnet"},
+		request: types.SubmitTranslationRequest{Word: 4615, Language: "ru", Name: "слово", Timestamp: "2020-01-01T00:00:00+01:00", Version: 2},
+		value:   "17:idena-translation1:27:testnet17:submitTranslation4:46152:ru10:слово0:25:2020-01-01T00:00:00+01:00",
+	},
+	{
+		format:  signing.Format{Network: "mainnet", AcceptLegacy: true},
+		request: types.SubmitTranslationRequest{Word: 1, Language: "id", Name: "name", Description: "desc", Timestamp: "2020-01-01T00:00:00Z"},
+		value:   "1idnamedesc2020-01-01T00:00:00Z",
+	},
+	{
+		format:  signing.Format{Network: "mainnet", AcceptLegacy: true},
+		request: types.SubmitTranslationRequest{Word: 1, Language: "id", Name: "name", Description: "desc", Timestamp: "2020-01-01T00:00:00Z", Version: 1},
+		value:   "1idnamedesc2020-01-01T00:00:00Z",
+	},
+}
+
+var votePayloadVectors = []struct {
+	format  signing.Format
+	request types.VoteRequest
+	value   string
+}{
+	{
+		format:  signing.Format{Network: "testnet"},
+		request: types.VoteRequest{TranslationId: "7", Up: true, Timestamp: "2020-01-01T01:00:00Z", Version: 2},
+		value:   "17:idena-translation1:27:testnet4:vote1:74:true20:2020-01-01T01:00:00Z",
+	},
+	{
+		format:  signing.Format{Network: "mainnet"},
+		request: types.VoteRequest{TranslationId: "123", Up: false, Timestamp: "2020-01-01T01:00:00Z", Version: 2},
+		value:   "17:idena-translation1:27:mainnet4:vote3:1235:false20:2020-01-01T01:00:00Z",
+	},
+	{
+		format:  signing.Format{Network: "mainnet", AcceptLegacy: true},
+		request: types.VoteRequest{TranslationId: "7", Up: true, Timestamp: "2020-01-01T01:00:00Z"},
+		value:   "7true2020-01-01T01:00:00Z",
+	},
+}
+
+var reportPayloadVectors = []struct {
+	format  signing.Format
+	request types.ReportRequest
+	value   string
+}{
+	{
+		format:  signing.Format{Network: "mainnet", AcceptLegacy: true},
+		request: types.ReportRequest{TranslationId: "7", Reason: "spam", Timestamp: "2020-01-01T01:00:00Z", Version: 2},
+		value:   "17:idena-translation1:27:mainnet6:report1:74:spam20:2020-01-01T01:00:00Z",
+	},
+}
+
+var retractVotePayloadVectors = []struct {
+	format  signing.Format
+	request types.RetractVoteRequest
+	value   string
+}{
+	{
+		format:  signing.Format{Network: "mainnet", AcceptLegacy: true},
+		request: types.RetractVoteRequest{TranslationId: "7", Timestamp: "2020-01-01T01:00:00Z", Version: 2},
+		value:   "17:idena-translation1:27:mainnet11:retractVote1:720:2020-01-01T01:00:00Z",
+	},
+}
+
+func Test_moderatedTranslationVotes(t *testing.T) {
+	const moderatorApiKey = "moderatorKey"
+	s, _, cl, nodeClient := startTestServerWithConfig(config.ServerConfig{Port: port, ModeratorApiKey: moderatorApiKey}, testEngineConfig{
+		scoring:       db.Scoring{ConfirmedRate: 1},
+		hideThreshold: 2,
+	})
+	defer s.Stop()
+	for _, address := range []string{"address1", "address2", "address3", "address4", "address5"} {
+		nodeClient.IdentitiesByAddr[address] = node.Identity{State: "Verified"}
+	}
+	var translationId string
+	for i, timestamp := range []string{"2020-01-01T01:00:00Z", "2020-01-01T02:00:00Z"} {
+		submitRes, err := cl.Translation.SubmitTranslation(&translation.SubmitTranslationParams{
+			Translation: signedSubmitTransactionRequest(&models.SubmitTranslationRequest{
+				Word: 1, Language: "id", Name: fmt.Sprintf("name%v", i), Timestamp: timestamp,
+			}, "address1", nodeClient.AddressesByValueAndSignature),
+			Context: context.Background(),
+		})
+		require.Nil(t, err)
+		require.Equal(t, int64(types.SuccessResCode), submitRes.GetPayload().ResCode)
+		translationId = submitRes.GetPayload().TranslationID
+	}
+	vote := func(address string) (*translation.VoteOK, error) {
+		return cl.Translation.Vote(&translation.VoteParams{
+			Vote: signedVoteRequest(&models.VoteRequest{
+				TranslationID: translationId, Up: true, Timestamp: time.Now().UTC().Format(time.RFC3339),
+			}, address, nodeClient.AddressesByValueAndSignature),
+			Context: context.Background(),
+		})
+	}
+	retractVote := func(address string) (*translation.RetractVoteOK, error) {
+		return cl.Translation.RetractVote(&translation.RetractVoteParams{
+			Retraction: signedRetractVoteRequest(&models.RetractVoteRequest{
+				TranslationID: translationId, Timestamp: time.Now().Add(time.Second).UTC().Format(time.RFC3339), Version: 2,
+			}, address, nodeClient.AddressesByValueAndSignature),
+			Context: context.Background(),
+		})
+	}
+	historyLen := func() int {
+		res, err := cl.Translation.GetTranslationHistory(&translation.GetTranslationHistoryParams{
+			ID: translationId, Context: context.Background(),
+		})
+		require.Nil(t, err)
+		return len(res.GetPayload().Revisions)
+	}
+	voteRes, err := vote("address4")
+	require.Nil(t, err)
+	require.Equal(t, int64(types.SuccessResCode), voteRes.GetPayload().ResCode)
+	require.Equal(t, 1, historyLen())
+	for _, address := range []string{"address2", "address3"} {
+		res, err := cl.Translation.Report(&translation.ReportParams{
+			Report: signedReportRequest(&models.ReportRequest{
+				TranslationID: translationId, Reason: models.ReportRequestReasonSpam, Timestamp: time.Now().UTC().Format(time.RFC3339), Version: 2,
+			}, address, nodeClient.AddressesByValueAndSignature),
+			Context: context.Background(),
+		})
+		require.Nil(t, err)
+		require.Equal(t, int64(types.SuccessResCode), res.GetPayload().ResCode)
+	}
+
+	// When
+	_, err = vote("address5")
+	// Then
+	require.IsType(t, &translation.VoteBadRequest{}, err)
+
+	// When
+	_, err = retractVote("address4")
+	// Then
+	require.IsType(t, &translation.RetractVoteBadRequest{}, err)
+	require.Zero(t, historyLen())
+
+	// When
+	_, err = cl.Translation.RestoreTranslation(&translation.RestoreTranslationParams{
+		APIKey: moderatorApiKey, ID: translationId, Context: context.Background(),
+	})
+	require.Nil(t, err)
+	voteRes, err = vote("address5")
+	// Then
+	require.Nil(t, err)
+	require.Equal(t, int64(types.SuccessResCode), voteRes.GetPayload().ResCode)
+	require.Equal(t, int64(2), voteRes.GetPayload().UpVotes)
+	require.Equal(t, 1, historyLen())
+
+	// When
+	retractRes, err := retractVote("address4")
+	// Then
+	require.Nil(t, err)
+	require.Equal(t, int64(types.SuccessResCode), retractRes.GetPayload().ResCode)
+	require.Equal(t, int64(1), retractRes.GetPayload().UpVotes)
+}
+
+func Test_signingPayload(t *testing.T) {
+	for _, vector := range submitTranslationPayloadVectors {
+		// When
+		value, err := vector.format.SubmitTranslationValue(vector.request)
+		// Then
+		require.Nil(t, err)
+		require.Equal(t, vector.value, value)
+	}
+	for _, vector := range votePayloadVectors {
+		// When
+		value, err := vector.format.VoteValue(vector.request)
+		// Then
+		require.Nil(t, err)
+		require.Equal(t, vector.value, value)
+	}
+	for _, vector := range reportPayloadVectors {
+		// When
+		value, err := vector.format.ReportValue(vector.request)
+		// Then
+		require.Nil(t, err)
+		require.Equal(t, vector.value, value)
+	}
+	for _, vector := range retractVotePayloadVectors {
+		// When
+		value, err := vector.format.RetractVoteValue(vector.request)
+		// Then
+		require.Nil(t, err)
+		require.Equal(t, vector.value, value)
+	}
+
+	// When
+	value1, _ := signing.Format{}.SubmitTranslationValue(types.SubmitTranslationRequest{Word: 1, Language: "id", Name: "x", Version: 2})
+	value2, _ := signing.Format{}.SubmitTranslationValue(types.SubmitTranslationRequest{Word: 1, Language: "i", Name: "dx", Version: 2})
+	// Then
+	require.NotEqual(t, value1, value2)
+
+	// When
+	_, err := signing.Format{Network: "mainnet"}.VoteValue(types.VoteRequest{TranslationId: "7", Timestamp: "2020-01-01T01:00:00Z"})
+	// Then
+	require.IsType(t, &types.BadRequestError{}, err)
+
+	// When
+	_, err = signing.Format{Network: "mainnet", AcceptLegacy: true}.VoteValue(types.VoteRequest{TranslationId: "7", Version: 3})
+	// Then
+	require.IsType(t, &types.BadRequestError{}, err)
+
+	// When
+	_, err = signing.Format{Network: "mainnet", AcceptLegacy: true}.ReportValue(types.ReportRequest{TranslationId: "7", Reason: "spam"})
+	// Then
+	require.IsType(t, &types.BadRequestError{}, err)
+
+	// When
+	_, err = signing.Format{Network: "mainnet", AcceptLegacy: true}.RetractVoteValue(types.RetractVoteRequest{TranslationId: "7"})
+	// Then
+	require.IsType(t, &types.BadRequestError{}, err)
+
+	// Signatures are made by an independent secp256k1 implementation with private keys 1 and 2
+	address, err := crypto.SignatureAddress(submitTranslationPayloadVectors[0].value, "0x74ab77d7b4dc243fea15e622023ea2be5997346266f53dae26de532294593aa25e36ad198eef324036ca181623385e70af97d78ed14e5628438b71d2c18dabf700")
+	require.Nil(t, err)
+	require.Equal(t, "0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf", address)
+	address, err = crypto.SignatureAddress(votePayloadVectors[0].value, "0x1d009f7c37f2c731787da56485ae4d82f4729b2a5067d490badfa5b84c6be8940be273f38aab4e416443e1dafcd6c3e73093c648ce57faae310091b663d2ca3b01")
+	require.Nil(t, err)
+	require.Equal(t, "0x2B5AD5c4795c026514f8317c7a215E218DcCD6cF", address)
+}
+
+func Test_legacySignatures(t *testing.T) {
+	s, _, cl, nodeClient := startTestServerWithConfig(config.ServerConfig{Port: port}, testEngineConfig{
+		scoring:                db.Scoring{ConfirmedRate: 3},
+		rejectLegacySignatures: true,
+	})
+	defer s.Stop()
+	nodeClient.IdentitiesByAddr["address1"] = node.Identity{State: "Verified"}
+
+	// When
+	_, err := cl.Translation.SubmitTranslation(&translation.SubmitTranslationParams{
+		Translation: signedSubmitTransactionRequest(&models.SubmitTranslationRequest{
+			Word: 1, Language: "id", Name: "name", Description: "desc", Timestamp: "2020-01-01T00:00:00Z",
+		}, "address1", nodeClient.AddressesByValueAndSignature),
+		Context: context.Background(),
+	})
+	// Then
+	require.IsType(t, &translation.SubmitTranslationBadRequest{}, err)
+
+	// When
+	signature := "signature"
+	nodeClient.AddressesByValueAndSignature[submitTranslationPayloadVectors[0].value+signature] = "address1"
+	res, err := cl.Translation.SubmitTranslation(&translation.SubmitTranslationParams{
+		Translation: &models.SubmitTranslationRequest{
+			Word: 1, Language: "id", Name: "name", Description: "desc", Timestamp: "2020-01-01T00:00:00Z", Version: 2, Signature: signature,
+		},
+		Context: context.Background(),
+	})
+	// Then
+	require.Nil(t, err)
+	require.Equal(t, int64(types.SuccessResCode), res.GetPayload().ResCode)
+}
+
+// Signatures are made by an independent secp256k1 implementation with keys whose addresses are well known
+var signatureVectors = []struct {
+	value     string
+	signature string
+	address   string
+}{
+	{
+		value:     "1idnamedesc2020-01-01T00:00:00Z",
+		signature: "0xd47644539acec3da5e3ecf5fe8863c628a9c97e8b71e9ea9167a6f4f83c03c3278f80cb5089612e3ec0443dadcf38f2106df83b779594ff95cf7473258e5ccbc00",
+		address:   "0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf",
+	},
+	{
+		value:     "7true2020-01-01T01:00:00Z",
+		signature: "0xdffddd21517dad742db39f6fb4f613effd6ce61ed9e08c5d9b279b6825b021980665f9e612d97d9bab837b9a3feb028cd6d010551c80493eeec070be648bca7301",
+		address:   "0x2B5AD5c4795c026514f8317c7a215E218DcCD6cF",
+	},
+	{
+		value:     "hello",
+		signature: "0x0be18eb7e57ff9114bf5544deb9bf92b46ee102d2dde1de301190275d7406f293600d5841525894d93b30f6b19a23c40b6d1aafe7d6f076d2c6ae5e8db7b6c9a00",
+		address:   "0x2c7536E3605D9C16a7a3D7b1898e529396a65c23",
+	},
+}
+
+func Test_signatureRecovery(t *testing.T) {
+	require.Equal(t, "c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470", hex.EncodeToString(crypto.Keccak256([]byte(""))))
+	require.Equal(t, "4e03657aea45a94fc7d47ba826c8d667c0d1e6e33a64a036ec44f58fa12d6c45", hex.EncodeToString(crypto.Keccak256([]byte("abc"))))
+
+	// The node emulator serves the addresses of the vectors
+	nodeServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/SignatureAddress" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		for _, vector := range signatureVectors {
+			if r.URL.Query().Get("value") == vector.value && r.URL.Query().Get("signature") == vector.signature {
+				fmt.Fprintf(w, `{"result":%q}`, vector.address)
+				return
+			}
+		}
+		fmt.Fprint(w, `{"error":{"message":"invalid signature"}}`)
+	}))
+	defer nodeServer.Close()
+	remoteClient := node.NewClient([]string{nodeServer.URL}, node.ClientConfig{Timeout: time.Second * 5})
+	localClient := node.NewLocalSignatureClient(remoteClient)
+
+	for _, vector := range signatureVectors {
+		// When
+		localAddress, localErr := localClient.GetSignatureAddress(context.Background(), vector.value, vector.signature)
+		remoteAddress, remoteErr := remoteClient.GetSignatureAddress(context.Background(), vector.value, vector.signature)
+		// Then
+		require.Nil(t, localErr)
+		require.Nil(t, remoteErr)
+		require.Equal(t, vector.address, localAddress)
+		require.Equal(t, remoteAddress, localAddress)
+	}
+
+	// When
+	address, err := localClient.GetSignatureAddress(context.Background(), "anotherValue", signatureVectors[0].signature)
+	// Then
+	require.Nil(t, err)
+	require.NotEqual(t, signatureVectors[0].address, address)
+
+	for _, signature := range []string{"", "0x01", "zz", signatureVectors[0].signature[:len(signatureVectors[0].signature)-2] + "04"} {
+		// When
+		_, err := localClient.GetSignatureAddress(context.Background(), "value", signature)
+		// Then
+		_, ok := err.(*types.BadRequestError)
+		require.True(t, ok)
+	}
+}
+
+func Test_nodeFailover(t *testing.T) {
+	var downRequests, upRequests int32
+	var downAvailable int32
+	downServer := httptest.NewServer(nodeEmulator(&downAvailable, &downRequests))
+	defer downServer.Close()
+	upAvailable := int32(1)
+	upServer := httptest.NewServer(nodeEmulator(&upAvailable, &upRequests))
+	defer upServer.Close()
+	nodeClient := node.NewClient([]string{downServer.URL, upServer.URL}, node.ClientConfig{
+		Timeout:         time.Second,
+		Retries:         1,
+		RetryBackoff:    time.Millisecond,
+		BreakerFailures: 2,
+		BreakerCooldown: time.Hour,
+	})
+
+	for i := 0; i < 6; i++ {
+		// When
+		identity, err := nodeClient.GetIdentity(context.Background(), "address1")
+		// Then
+		require.Nil(t, err)
+		require.Equal(t, node.Identity{State: "Verified", Age: 3}, identity)
+	}
+	// The breaker is open after 2 failures, so the down node is not requested anymore
+	require.Equal(t, int32(2), atomic.LoadInt32(&downRequests))
+	require.Equal(t, int32(6), atomic.LoadInt32(&upRequests))
+	endpoints := nodeClient.Endpoints()
+	require.Equal(t, 2, len(endpoints))
+	require.Equal(t, downServer.URL, endpoints[0].Url)
+	require.Equal(t, node.BreakerOpen, endpoints[0].Breaker)
+	require.Equal(t, 2, endpoints[0].ConsecutiveFailures)
+	require.Equal(t, "node "+downServer.URL+" is unavailable: resp code 500", endpoints[0].LastError)
+	require.Equal(t, node.BreakerClosed, endpoints[1].Breaker)
+	require.Equal(t, 0, endpoints[1].ConsecutiveFailures)
+
+	// When
+	_, err := nodeClient.GetSignatureAddress(context.Background(), "value", "invalid")
+	// Then the error of the node is returned without retries
+	require.IsType(t, &types.BadRequestError{}, err)
+	require.Equal(t, int32(7), atomic.LoadInt32(&upRequests))
+
+	// When
+	atomic.StoreInt32(&upAvailable, 0)
+	_, err = nodeClient.GetIdentity(context.Background(), "address1")
+	// Then
+	require.NotNil(t, err)
+	require.Equal(t, int32(2), atomic.LoadInt32(&downRequests))
+	require.Equal(t, int32(9), atomic.LoadInt32(&upRequests))
+
+	// Health checks find the unavailable node before requests are sent
+	checkedClient := node.NewClient([]string{downServer.URL, upServer.URL}, node.ClientConfig{
+		Timeout:             time.Second,
+		BreakerFailures:     3,
+		BreakerCooldown:     time.Hour,
+		HealthCheckInterval: time.Millisecond * 20,
+	})
+	atomic.StoreInt32(&upAvailable, 1)
+	atomic.StoreInt32(&downRequests, 0)
+	require.Eventually(t, func() bool {
+		endpoints := checkedClient.Endpoints()
+		return !endpoints[0].Healthy && endpoints[1].Healthy && endpoints[1].LastEpoch == 12
+	}, time.Second*5, time.Millisecond*10)
+	for i := 0; i < 4; i++ {
+		// When
+		epoch, err := checkedClient.LastEpoch(context.Background())
+		// Then
+		require.Nil(t, err)
+		require.Equal(t, uint16(12), epoch)
+	}
+
+	// When the node is back
+	atomic.StoreInt32(&downAvailable, 1)
+	// Then
+	require.Eventually(t, func() bool {
+		endpoints := checkedClient.Endpoints()
+		return endpoints[0].Healthy && endpoints[0].Breaker == node.BreakerClosed && endpoints[0].ConsecutiveFailures == 0
+	}, time.Second*5, time.Millisecond*10)
+}
+
+// nodeEmulator answers requests of the node rest api while available is 1 and fails with 500 otherwise
+func nodeEmulator(available *int32, requests *int32) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/epoch/last" {
+			atomic.AddInt32(requests, 1)
+		}
+		if atomic.LoadInt32(available) != 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		switch {
+		case r.URL.Path == "/api/epoch/last":
+			fmt.Fprint(w, `{"result":{"epoch":12}}`)
+		case strings.HasPrefix(r.URL.Path, "/api/identity/"):
+			fmt.Fprint(w, `{"result":{"state":"Verified","age":3}}`)
+		default:
+			fmt.Fprint(w, `{"error":{"message":"invalid signature"}}`)
+		}
+	}
+}
+
+func Test_nodeStatus(t *testing.T) {
+	s, _, cl, nodeClient := startTestServer()
+	defer s.Stop()
+	nodeClient.EndpointStatuses = []node.EndpointStatus{
+		{Url: "http://node1", Healthy: true, Breaker: node.BreakerClosed, LastEpoch: 12,
+			LastCheck: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{Url: "http://node2", Breaker: node.BreakerOpen, ConsecutiveFailures: 3, LastError: "resp code 500"},
+	}
+
+	// When
+	_, err := cl.Translation.GetNodeStatus(&translation.GetNodeStatusParams{
+		APIKey: "wrongKey", Context: context.Background(),
+	})
+	// Then
+	require.IsType(t, &translation.GetNodeStatusForbidden{}, err)
+
+	// When
+	res, err := cl.Translation.GetNodeStatus(&translation.GetNodeStatusParams{
+		APIKey: adminApiKey, Context: context.Background(),
+	})
+	// Then
+	require.Nil(t, err)
+	require.Equal(t, []*models.NodeStatus{
+		{URL: "http://node1", Healthy: true, Breaker: models.NodeStatusBreakerClosed, LastEpoch: 12, LastCheck: "2020-01-01T00:00:00Z"},
+		{URL: "http://node2", Breaker: models.NodeStatusBreakerOpen, ConsecutiveFailures: 3, LastError: "resp code 500"},
+	}, res.GetPayload().Nodes)
+	require.Nil(t, res.GetPayload().IdentityCache)
+}
+
+func Test_nodeStatusIdentityCache(t *testing.T) {
+	s, _, cl, nodeClient := startTestServerWithConfig(config.ServerConfig{Port: port, AdminApiKey: adminApiKey}, testEngineConfig{
+		scoring:          db.Scoring{ConfirmedRate: 3},
+		identityCacheTtl: time.Hour,
+	})
+	defer s.Stop()
+	nodeClient.IdentitiesByAddr["address1"] = node.Identity{State: "Verified"}
+	for word := int64(1); word <= 3; word++ {
+		res, err := cl.Translation.SubmitTranslation(&translation.SubmitTranslationParams{
+			Translation: signedSubmitTransactionRequest(&models.SubmitTranslationRequest{
+				Word: word, Language: "id", Name: "name", Timestamp: time.Now().UTC().Format(time.RFC3339),
+			}, "address1", nodeClient.AddressesByValueAndSignature),
+			Context: context.Background(),
+		})
+		require.Nil(t, err)
+		require.Equal(t, int64(types.SuccessResCode), res.GetPayload().ResCode)
+	}
+
+	// When
+	res, err := cl.Translation.GetNodeStatus(&translation.GetNodeStatusParams{
+		APIKey: adminApiKey, Context: context.Background(),
+	})
+	// Then
+	require.Nil(t, err)
+	require.Equal(t, &models.IdentityCacheStats{Hits: 2, Misses: 1}, res.GetPayload().IdentityCache)
+}
+
+// Test_nodeBackends runs the same contract against the rest api of the indexer and the json-rpc api of the node
+func Test_nodeBackends(t *testing.T) {
+	const (
+		apiKey    = "nodeApiKey"
+		address   = "0x6ef4d8ef1a4cea2c8f6d4a8ba38fb22e17f4ff09"
+		value     = "value"
+		signature = "0x01"
+	)
+	restServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/epoch/last":
+			fmt.Fprint(w, `{"result":{"epoch":12}}`)
+		case r.URL.Path == "/api/identity/"+address:
+			fmt.Fprint(w, `{"result":{"address":"`+address+`","state":"Verified","age":3}}`)
+		case strings.HasPrefix(r.URL.Path, "/api/identity/"):
+			fmt.Fprint(w, `{"error":{"message":"no data found"}}`)
+		case r.URL.Path == "/api/SignatureAddress" && r.URL.Query().Get("value") == value && r.URL.Query().Get("signature") == signature:
+			fmt.Fprintf(w, `{"result":%q}`, address)
+		default:
+			fmt.Fprint(w, `{"error":{"message":"invalid signature"}}`)
+		}
+	}))
+	defer restServer.Close()
+	rpcServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Id     int           `json:"id"`
+			Method string        `json:"method"`
+			Params []interface{} `json:"params"`
+			Key    string        `json:"key"`
+		}
+		if r.Method != "POST" || json.NewDecoder(r.Body).Decode(&req) != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		writeResult := func(result string) {
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%v,"result":%v}`, req.Id, result)
+		}
+		writeError := func(message string) {
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%v,"error":{"code":-32000,"message":%q}}`, req.Id, message)
+		}
+		switch {
+		case req.Key != apiKey:
+			writeError("the provided API key is invalid")
+		case req.Method == "dna_epoch":
+			writeResult(`{"epoch":12,"nextValidation":"2020-01-01T00:00:00Z","currentPeriod":"None"}`)
+		case req.Method == "dna_identity" && len(req.Params) == 1 && req.Params[0] == address:
+			writeResult(`{"address":"` + address + `","state":"Verified","age":3}`)
+		case req.Method == "dna_identity" && len(req.Params) == 1:
+			writeResult(fmt.Sprintf(`{"address":%q,"state":"Undefined","age":0}`, req.Params[0]))
+		case req.Method == "dna_signatureAddress" && len(req.Params) == 2 && req.Params[0] == value && req.Params[1] == signature:
+			writeResult(fmt.Sprintf("%q", address))
+		case req.Method == "dna_signatureAddress":
+			writeError("invalid signature")
+		default:
+			writeError("the method " + req.Method + " does not exist/is not available")
+		}
+	}))
+	defer rpcServer.Close()
+	clientConfig := node.ClientConfig{Timeout: time.Second}
+	backends := []struct {
+		name   string
+		server *httptest.Server
+		client node.Client
+	}{
+		{"rest", restServer, node.NewClient([]string{restServer.URL}, clientConfig)},
+		{"rpc", rpcServer, node.NewRpcClient([]string{rpcServer.URL}, apiKey, clientConfig)},
+	}
+
+	for _, backend := range backends {
+		t.Run(backend.name, func(t *testing.T) {
+			// When
+			epoch, err := backend.client.LastEpoch(context.Background())
+			// Then
+			require.Nil(t, err)
+			require.Equal(t, uint16(12), epoch)
+
+			// When
+			identity, err := backend.client.GetIdentity(context.Background(), address)
+			// Then
+			require.Nil(t, err)
+			require.Equal(t, node.Identity{State: "Verified", Age: 3}, identity)
+
+			// When
+			identity, err = backend.client.GetIdentity(context.Background(), "0x0000000000000000000000000000000000000001")
+			// Then
+			require.Nil(t, err)
+			require.Equal(t, node.Identity{}, identity)
+
+			// When
+			signatureAddress, err := backend.client.GetSignatureAddress(context.Background(), value, signature)
+			// Then
+			require.Nil(t, err)
+			require.Equal(t, address, signatureAddress)
+
+			// When
+			_, err = backend.client.GetSignatureAddress(context.Background(), value, "0x02")
+			// Then
+			require.IsType(t, &types.BadRequestError{}, err)
+			require.Equal(t, "invalid signature", err.Error())
+			require.Equal(t, 0, backend.client.Endpoints()[0].ConsecutiveFailures)
+		})
+	}
+
+	// When
+	_, err := node.NewRpcClient([]string{rpcServer.URL}, "wrongKey", clientConfig).LastEpoch(context.Background())
+	// Then
+	require.NotNil(t, err)
+	require.Equal(t, "the provided API key is invalid", err.Error())
+
+	for _, backend := range backends {
+		backend.server.Close()
+		// When
+		_, err := backend.client.GetIdentity(context.Background(), address)
+		// Then
+		require.NotNil(t, err)
+		require.Equal(t, 1, backend.client.Endpoints()[0].ConsecutiveFailures)
+	}
+}
+
+func Test_readMigrations(t *testing.T) {
+	// Migrations are read before connecting so the connection string is never used
+	const connStr = "host=127.0.0.1 port=1 sslmode=disable"
+	createScriptsDir := func(fileNames ...string) string {
+		scriptsDir, err := ioutil.TempDir("", "migrations")
+		require.Nil(t, err)
+		t.Cleanup(func() {
+			os.RemoveAll(scriptsDir)
+		})
+		require.Nil(t, os.Mkdir(filepath.Join(scriptsDir, "migrations"), 0755))
+		for _, fileName := range fileNames {
+			require.Nil(t, ioutil.WriteFile(filepath.Join(scriptsDir, "migrations", fileName), []byte("SELECT 1"), 0644))
+		}
+		return scriptsDir
+	}
+
+	cases := []struct {
+		name      string
+		fileNames []string
+		version   int
+		err       string
+	}{
+		{
+			name:      "up and down scripts are paired by version, the down script and other files are optional",
+			fileNames: []string{"0001_init.up.sql", "0001_init.down.sql", "0002_votes.up.sql", "readme.md", "0003_draft.sql"},
+			version:   3,
+			err:       "unknown schema version 3",
+		},
+		{
+			name:      "missing version",
+			fileNames: []string{"0001_init.up.sql", "0003_votes.up.sql"},
+			version:   postgres.LatestVersion,
+			err:       "migration 2 is missing",
+		},
+		{
+			name:      "no first version",
+			fileNames: []string{"0002_votes.up.sql"},
+			version:   postgres.LatestVersion,
+			err:       "migration 1 is missing",
+		},
+		{
+			name:      "no up script",
+			fileNames: []string{"0001_init.up.sql", "0002_votes.down.sql"},
+			version:   postgres.LatestVersion,
+			err:       "migration 2 has no up script",
+		},
+		{
+			name:      "different names of up and down scripts",
+			fileNames: []string{"0001_init.up.sql", "0001_schema.down.sql"},
+			version:   postgres.LatestVersion,
+			err:       "migration 1 has different names: init, schema",
+		},
+		{
+			name:      "duplicated version",
+			fileNames: []string{"0001_init.up.sql", "0001_votes.up.sql"},
+			version:   postgres.LatestVersion,
+			err:       "migration 1 has several up scripts: 0001_init.up.sql, 0001_votes.up.sql",
+		},
+		{
+			name:      "duplicated script",
+			fileNames: []string{"0001_init.up.sql", "1_init.up.sql"},
+			version:   postgres.LatestVersion,
+			err:       "migration 1 has several up scripts: 0001_init.up.sql, 1_init.up.sql",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			// When
+			err := postgres.Migrate(connStr, createScriptsDir(c.fileNames...), c.version)
+			// Then
+			require.NotNil(t, err)
+			require.Contains(t, err.Error(), c.err)
+		})
+	}
+
+	// When
+	err := postgres.Migrate(connStr, createScriptsDir(), 1)
+	// Then
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "unknown schema version 1")
+}
+
+func Test_migrations(t *testing.T) {
+	if !*usePostgres {
+		t.Skip("migrations are only tested with -postgres")
+	}
+	schemaConnStr := initPostgresSchema()
+	upScripts, err := filepath.Glob("../resources/migrations/*.up.sql")
+	require.Nil(t, err)
+	latestVersion := len(upScripts)
+	require.NotZero(t, latestVersion)
+	sqlDb, err := sql.Open("postgres", schemaConnStr)
+	require.Nil(t, err)
+	defer sqlDb.Close()
+	requireVersion := func(version int) {
+		schemaVersion, err := postgres.SchemaVersion(schemaConnStr)
+		require.Nil(t, err)
+		require.Equal(t, version, schemaVersion)
+		rows, err := sqlDb.Query("SELECT version, name FROM schema_version ORDER BY version")
+		require.Nil(t, err)
+		defer rows.Close()
+		var versions []int
+		for rows.Next() {
+			var v int
+			var name string
+			require.Nil(t, rows.Scan(&v, &name))
+			require.Equal(t, fmt.Sprintf("../resources/migrations/%04d_%v.up.sql", v, name), upScripts[v-1])
+			versions = append(versions, v)
+		}
+		require.Nil(t, rows.Err())
+		require.Len(t, versions, version)
+		for i, v := range versions {
+			require.Equal(t, i+1, v)
+		}
+	}
+	tableExists := func(table string) bool {
+		var exists bool
+		require.Nil(t, sqlDb.QueryRow("SELECT to_regclass($1) IS NOT NULL", table).Scan(&exists))
+		return exists
+	}
+
+	// When
+	err = postgres.Migrate(schemaConnStr, "../resources", 1)
+	// Then
+	require.Nil(t, err)
+	requireVersion(1)
+	require.True(t, tableExists("translations"))
+
+	// When
+	err = postgres.Migrate(schemaConnStr, "../resources", postgres.LatestVersion)
+	// Then
+	require.Nil(t, err)
+	requireVersion(latestVersion)
+
+	// Down migrations are applied one by one in reverse order
+	for version := latestVersion - 1; version >= 0; version-- {
+		// When
+		err = postgres.Migrate(schemaConnStr, "../resources", version)
+		// Then
+		require.Nil(t, err)
+		requireVersion(version)
+	}
+	require.False(t, tableExists("translations"))
+
+	// Up migrations are applied one by one in order
+	for version := 1; version <= latestVersion; version++ {
+		// When
+		err = postgres.Migrate(schemaConnStr, "../resources", version)
+		// Then
+		require.Nil(t, err)
+		requireVersion(version)
+	}
+
+	// When
+	err = postgres.Migrate(schemaConnStr, "../resources", latestVersion+1)
+	// Then
+	require.NotNil(t, err)
+	requireVersion(latestVersion)
+}
+
+func Test_concurrentMigrations(t *testing.T) {
+	if !*usePostgres {
+		t.Skip("migrations are only tested with -postgres")
+	}
+	schemaConnStr := initPostgresSchema()
+	upScripts, err := filepath.Glob("../resources/migrations/*.up.sql")
+	require.Nil(t, err)
+	sqlDb, err := sql.Open("postgres", schemaConnStr)
+	require.Nil(t, err)
+	defer sqlDb.Close()
+	conn, err := sqlDb.Conn(context.Background())
+	require.Nil(t, err)
+	defer conn.Close()
+	// The key of the advisory lock taken by Migrate
+	const migrationLockKey = 7342180523
+	_, err = conn.ExecContext(context.Background(), "SELECT pg_advisory_lock($1)", migrationLockKey)
+	require.Nil(t, err)
+
+	// When
+	errs := make(chan error, 5)
+	for i := 0; i < cap(errs); i++ {
+		go func() {
+			errs <- postgres.Migrate(schemaConnStr, "../resources", postgres.LatestVersion)
+		}()
+	}
+	// Then
+	select {
+	case err := <-errs:
+		require.Failf(t, "migration is not blocked by the lock", "error: %v", err)
+	case <-time.After(time.Millisecond * 300):
+	}
+	var migrated bool
+	require.Nil(t, conn.QueryRowContext(context.Background(), "SELECT to_regclass('schema_version') IS NOT NULL").Scan(&migrated))
+	require.False(t, migrated)
+
+	// When
+	_, err = conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", migrationLockKey)
+	require.Nil(t, err)
+	// Then
+	for i := 0; i < cap(errs); i++ {
+		require.Nil(t, <-errs)
+	}
+	var count int
+	require.Nil(t, sqlDb.QueryRow("SELECT count(*) FROM schema_version").Scan(&count))
+	require.Equal(t, len(upScripts), count)
+	schemaVersion, err := postgres.SchemaVersion(schemaConnStr)
+	require.Nil(t, err)
+	require.Equal(t, len(upScripts), schemaVersion)
+}
+
+func Test_replicaFallback(t *testing.T) {
+	if !*usePostgres {
+		t.Skip("replicas are only tested with -postgres")
+	}
+	schemaConnStr := initPostgresSchema()
+	proxy := newTcpProxy(t, "localhost:5432")
+	dbAccessor := postgres.NewAccessor(config.PostgresConfig{
+		ConnStr:    schemaConnStr,
+		ScriptsDir: "../resources",
+		ReplicaConnStrs: []string{
+			// The replica that is down from the start
+			"postgres://postgres@localhost:1?sslmode=disable&search_path=" + schema,
+			fmt.Sprintf("postgres://postgres@%v?sslmode=disable&search_path=%v", proxy.Addr(), schema),
+		},
+		ReplicaCheckIntervalSec: 1,
+	})
+	getLanguages := func() {
+		for i := 0; i < 4; i++ {
+			languages, err := dbAccessor.GetLanguages(context.Background())
+			require.Nil(t, err)
+			require.NotEmpty(t, languages)
+		}
+	}
+
+	// When
+	getLanguages()
+	// Then
+	require.NotZero(t, proxy.Connections())
+
+	// When
+	proxy.Close()
+	// Then
+	getLanguages()
+
+	// When
+	time.Sleep(time.Millisecond * 1500)
+	// Then
+	getLanguages()
+}
+
+func Test_requestTimeout(t *testing.T) {
+	s, _, cl, nodeClient := startTestServerWithConfig(config.ServerConfig{
+		Port:              port,
+		RequestTimeoutSec: 60,
+		RouteTimeoutsSec:  map[string]int{"submitTranslation": 1},
+	}, defaultTestEngineConfig)
+	defer s.Stop()
+
+	const address = "address1"
+	nodeClient.Delay = time.Second * 30
+	nodeClient.IdentitiesByAddr[address] = node.Identity{State: "Verified"}
+
+	// When
+	start := time.Now()
+	_, err := cl.Translation.SubmitTranslation(&translation.SubmitTranslationParams{
+		Translation: signedSubmitTransactionRequest(&models.SubmitTranslationRequest{
+			Word: 1, Language: "id", Name: "name", Description: "desc", Timestamp: time.Now().UTC().Format(time.RFC3339),
+		}, address, nodeClient.AddressesByValueAndSignature),
+		Context: context.Background(),
+	})
+	// Then
+	require.NotNil(t, err)
+	apiErr, ok := err.(*runtime.APIError)
+	require.True(t, ok)
+	require.Equal(t, http.StatusServiceUnavailable, apiErr.Code)
+	require.Less(t, int64(time.Since(start)), int64(time.Second*10))
+
+	// When
+	nodeClient.Delay = time.Millisecond * 10
+	res, err := cl.Translation.SubmitTranslation(&translation.SubmitTranslationParams{
+		Translation: signedSubmitTransactionRequest(&models.SubmitTranslationRequest{
+			Word: 1, Language: "id", Name: "name", Description: "desc", Timestamp: time.Now().UTC().Format(time.RFC3339),
+		}, address, nodeClient.AddressesByValueAndSignature),
+		Context: context.Background(),
+	})
+	// Then
+	require.Nil(t, err)
+	require.Equal(t, int64(types.SuccessResCode), res.GetPayload().ResCode)
+}
+
+func signedSubmitTransactionRequest(
+	r *models.SubmitTranslationRequest,
+	address string,
+	addressesByValueAndSignature map[string]string,
+) *models.SubmitTranslationRequest {
+	val := strings.Join([]string{fmt.Sprint(r.Word), r.Language, r.Name, r.Description, r.Timestamp}, "")
+	signature := val
+	addressesByValueAndSignature[val+signature] = address
+	r.Signature = signature
+	return r
+}
+
+func signedVoteRequest(
+	r *models.VoteRequest,
+	address string,
+	addressesByValueAndSignature map[string]string,
+) *models.VoteRequest {
+	val := strings.Join([]string{r.TranslationID, fmt.Sprint(r.Up), r.Timestamp}, "")
+	signature := val
+	addressesByValueAndSignature[val+signature] = address
+	r.Signature = signature
+	return r
+}
+
+func signedReportRequest(
+	r *models.ReportRequest,
+	address string,
+	addressesByValueAndSignature map[string]string,
+) *models.ReportRequest {
+	val, err := signing.Format{Network: "mainnet"}.ReportValue(types.ReportRequest{
+		TranslationId: r.TranslationID,
+		Reason:        r.Reason,
+		Timestamp:     r.Timestamp,
+		Version:       uint8(r.Version),
+	})
+	if err != nil {
+		panic(err)
+	}
+	signature := val
+	addressesByValueAndSignature[val+signature] = address
+	r.Signature = signature
+	return r
+}
+
+func signedRetractVoteRequest(
+	r *models.RetractVoteRequest,
+	address string,
+	addressesByValueAndSignature map[string]string,
+) *models.RetractVoteRequest {
+	val, err := signing.Format{Network: "mainnet"}.RetractVoteValue(types.RetractVoteRequest{
+		TranslationId: r.TranslationID,
+		Timestamp:     r.Timestamp,
+		Version:       uint8(r.Version),
+	})
+	if err != nil {
+		panic(err)
+	}
+	signature := val
+	addressesByValueAndSignature[val+signature] = address
+	r.Signature = signature
+	return r
+}
+
+var usePostgres = flag.Bool("postgres", false, "run tests against local postgres instead of in-memory db")
+
+func startTestServer() (*server.Server, db.Accessor, *client.IdenaFlipWordsTranslation, *TestNodeClient) {
+	return startTestServerWithConfig(config.ServerConfig{Port: port, AdminApiKey: adminApiKey}, defaultTestEngineConfig)
+}
+
+type testEngineConfig struct {
+	scoring                db.Scoring
+	voteWeights            core.VoteWeights
+	requestWindow          time.Duration
+	rejectLegacySignatures bool
+	rateLimits             map[string]ratelimit.RouteLimits
+	hideThreshold          int
+	wordsUrl               string
+	// identityCacheTtl enables the identity cache in front of the test node client if it is positive
+	identityCacheTtl time.Duration
+}
+
+var defaultTestEngineConfig = testEngineConfig{
+	scoring: db.Scoring{ConfirmedRate: 3},
+}
+
+func startTestServerWithConfig(serverConfig config.ServerConfig, engineConfig testEngineConfig) (*server.Server, db.Accessor, *client.IdenaFlipWordsTranslation, *TestNodeClient) {
+	var dbAccessor db.Accessor
+	if *usePostgres {
+		dbAccessor = initPostgresAccessor()
+	} else {
+		dbAccessor = memory.NewAccessor()
+	}
+	nodeClient := &TestNodeClient{
+		IdentitiesByAddr:             make(map[string]node.Identity),
+		AddressesByValueAndSignature: make(map[string]string),
+	}
+	var engineNodeClient node.Client = nodeClient
+	if engineConfig.identityCacheTtl > 0 {
+		engineNodeClient = node.NewCachingClient(nodeClient, engineConfig.identityCacheTtl, 0)
+	}
+	tokenCodec := continuation.NewCodec([]byte("secret"), time.Hour)
+	auth := core.NewEngine(dbAccessor, engineNodeClient, 5, engineConfig.scoring, engineConfig.voteWeights, words_mapper.NewWordsMapper(engineConfig.wordsUrl), tokenCodec, replay.NewGuard(engineConfig.requestWindow), signing.Format{Network: "mainnet", AcceptLegacy: !engineConfig.rejectLegacySignatures}, ratelimit.NewLimiter(dbAccessor, engineConfig.rateLimits), engineConfig.hideThreshold)
+	s := server.NewServer(serverConfig, auth)
+	go s.Start(config.SwaggerConfig{})
+	waitForServer()
+	// Connections to the server of the previous test are dropped, otherwise a POST request can be sent to a connection
+	// closed by that server and fail without retry
+	http.DefaultTransport.(*http.Transport).CloseIdleConnections()
+	clConfig := client.DefaultTransportConfig().WithHost(fmt.Sprintf("localhost:%v", port))
+	cl := client.NewHTTPClientWithConfig(nil, clConfig)
+	return s, dbAccessor, cl, nodeClient
+}
+
+func waitForServer() {
+	for i := 0; i < 100; i++ {
+		if conn, err := net.Dial("tcp", fmt.Sprintf("localhost:%v", port)); err == nil {
+			conn.Close()
+			return
+		}
+		time.Sleep(time.Millisecond * 50)
+	}
+	panic("test server is not started")
+}
+
+func initPostgresAccessor() db.Accessor {
+	schemaConnStr := initPostgresSchema()
+	// The primary is also used as the replica so that read queries go through the replica routing
+	return postgres.NewAccessor(config.PostgresConfig{
+		ConnStr:         schemaConnStr,
+		ScriptsDir:      "../resources",
+		ReplicaConnStrs: []string{schemaConnStr},
+	})
+}
+
+// initPostgresSchema recreates the test schema and returns the connection string to it
+func initPostgresSchema() string {
+	dbConnector, err := sql.Open("postgres", connStr)
+	if err != nil {
+		panic(err)
+	}
+	_, err = dbConnector.Exec("DROP SCHEMA IF EXISTS " + schema + " CASCADE")
+	if err != nil {
+		panic(err)
+	}
+	_, err = dbConnector.Exec("CREATE SCHEMA " + schema)
+	if err != nil {
+		panic(err)
+	}
+	return connStr + "&search_path=" + schema
+}
+
+// tcpProxy forwards connections to the target address until it is closed, so the target looks down for clients of
+// the proxy
+type tcpProxy struct {
+	listener    net.Listener
+	target      string
+	mutex       sync.Mutex
+	conns       []net.Conn
+	connections int
+}
+
+func newTcpProxy(t *testing.T, target string) *tcpProxy {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	p := &tcpProxy{
+		listener: listener,
+		target:   target,
+	}
+	go p.serve()
+	return p
+}
+
+func (p *tcpProxy) Addr() string {
+	return p.listener.Addr().String()
+}
+
+// Connections returns the number of accepted connections
+func (p *tcpProxy) Connections() int {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	return p.connections
+}
+
+func (p *tcpProxy) serve() {
+	for {
+		conn, err := p.listener.Accept()
+		if err != nil {
+			return
+		}
+		targetConn, err := net.Dial("tcp", p.target)
+		if err != nil {
+			conn.Close()
+			continue
+		}
+		p.mutex.Lock()
+		p.conns = append(p.conns, conn, targetConn)
+		p.connections++
+		p.mutex.Unlock()
+		go io.Copy(targetConn, conn)
+		go io.Copy(conn, targetConn)
+	}
+}
+
+// Close stops accepting connections and breaks the accepted ones
+func (p *tcpProxy) Close() {
+	p.listener.Close()
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	for _, conn := range p.conns {
+		conn.Close()
+	}
+}
+
+type TestNodeClient struct {
+	IdentitiesByAddr map[string]node.Identity
+	// IdentityErrorsByAddr are errors returned instead of identities
+	IdentityErrorsByAddr         map[string]error
+	AddressesByValueAndSignature map[string]string
+	// Delay emulates slow node, calls return earlier if the context is done
+	Delay            time.Duration
+	EndpointStatuses []node.EndpointStatus
+	// MaxIdentityRequests is the maximum number of simultaneous identity requests
+	MaxIdentityRequests int32
+	identityRequests    int32
+	// epoch is read by caching clients in the background, so it is changed by SetEpoch
+	epoch uint32
+}
+
+func (t *TestNodeClient) GetSignatureAddress(ctx context.Context, value, signature string) (string, error) {
+	if err := t.wait(ctx); err != nil {
+		return "", err
+	}
+	return t.AddressesByValueAndSignature[value+signature], nil
+}
+
+func (t *TestNodeClient) GetIdentity(ctx context.Context, address string) (node.Identity, error) {
+	requests := atomic.AddInt32(&t.identityRequests, 1)
+	defer atomic.AddInt32(&t.identityRequests, -1)
+	for max := atomic.LoadInt32(&t.MaxIdentityRequests); requests > max; max = atomic.LoadInt32(&t.MaxIdentityRequests) {
+		if atomic.CompareAndSwapInt32(&t.MaxIdentityRequests, max, requests) {
+			break
+		}
+	}
+	if err := t.wait(ctx); err != nil {
+		return node.Identity{}, err
+	}
+	if err := t.IdentityErrorsByAddr[address]; err != nil {
+		return node.Identity{}, err
+	}
+	return t.IdentitiesByAddr[address], nil
+}
+
+func (t *TestNodeClient) LastEpoch(ctx context.Context) (uint16, error) {
+	return uint16(atomic.LoadUint32(&t.epoch)), nil
+}
+
+func (t *TestNodeClient) SetEpoch(epoch uint16) {
+	atomic.StoreUint32(&t.epoch, uint32(epoch))
+}
+
+func (t *TestNodeClient) Endpoints() []node.EndpointStatus {
+	return t.EndpointStatuses
+}
+
+func (t *TestNodeClient) wait(ctx context.Context) error {
+	if t.Delay == 0 {
+		return nil
+	}
+	select {
+	case <-time.After(t.Delay):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
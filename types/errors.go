@@ -9,6 +9,12 @@ const (
 	selfVotingResCode                 ResCode = 3
 	outdatedSubmissionResCode         ResCode = 4
 	duplicatedVoteResCode             ResCode = 5
+	timestampOutOfWindowResCode       ResCode = 6
+	replayedRequestResCode            ResCode = 7
+	rateLimitedResCode                ResCode = 8
+	selfReportingResCode              ResCode = 9
+	duplicatedReportResCode           ResCode = 10
+	noVoteResCode                     ResCode = 11
 )
 
 var (
@@ -32,6 +38,42 @@ var (
 		code:  duplicatedVoteResCode,
 		error: "Duplicated vote",
 	}
+	TimestampOutOfWindowError = &TranslationError{
+		code:  timestampOutOfWindowResCode,
+		error: "Request timestamp is too far from server time",
+	}
+	ReplayedRequestError = &TranslationError{
+		code:  replayedRequestResCode,
+		error: "Request is already accepted",
+	}
+	RateLimitedError = &TranslationError{
+		code:  rateLimitedResCode,
+		error: "Too many requests",
+	}
+	SelfReportingError = &TranslationError{
+		code:  selfReportingResCode,
+		error: "Reporting own translation is not allowed",
+	}
+	DuplicatedReportError = &TranslationError{
+		code:  duplicatedReportResCode,
+		error: "Duplicated report",
+	}
+	NoVoteError = &TranslationError{
+		code:  noVoteResCode,
+		error: "No vote to retract",
+	}
+)
+
+var (
+	InvalidContinuationTokenError = &BadRequestError{
+		Message: "invalid value 'continuation-token'",
+	}
+	MismatchedContinuationTokenError = &BadRequestError{
+		Message: "continuation token is issued for another list",
+	}
+	ExpiredContinuationTokenError = &BadRequestError{
+		Message: "continuation token is expired",
+	}
 )
 
 type TranslationError struct {
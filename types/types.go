@@ -7,47 +7,257 @@ type ErrorResponse struct {
 } // @Name ErrorResponse
 
 type SubmitTranslationRequest struct {
-	Word        uint32 `json:"word",minimum:"0" maximum:"4615"`
+	Word        uint32 `json:"word" minimum:"0" maximum:"4615"`
 	Language    string `json:"language" example:"en"`
-	Name        string `json:"name",minLength:"1" maxLength:"30"`
-	Description string `json:"description",minLength:"0" maxLength:"150"`
+	Name        string `json:"name" minLength:"1" maxLength:"30"`
+	Description string `json:"description" minLength:"0" maxLength:"150"`
 	Timestamp   string `json:"timestamp" example:"2020-01-01T00:00:00Z"`
-	Signature   string `json:"signature"`
+	// Version is the format of the signed value, requests without version are signed in the legacy format
+	Version   uint8  `json:"version,omitempty" enums:"1,2"`
+	Signature string `json:"signature"`
 } // @Name SubmitTranslationRequest
 
 type SubmitTranslationResponse struct {
-	ResCode       byte   `json:"resCode" enums:"0,1,2,4"`
+	ResCode       byte   `json:"resCode" enums:"0,1,2,4,6,7,8"`
 	TranslationId string `json:"translationId,omitempty"`
 	Error         string `json:"error,omitempty"`
+	// RetryAfter is the number of seconds after which the rate limited request can be repeated
+	RetryAfter int `json:"retryAfter,omitempty"`
 } // @Name SubmitTranslationResponse
 
 type GetTranslationsResponse struct {
 	Translations []Translation `json:"translations"`
+	// Total is the number of translations of the word, it is returned on request only
+	Total *int `json:"total,omitempty"`
 } // @Name GetTranslationsResponse
 
 type Translation struct {
-	Id          string `json:"id"`
-	Name        string `json:"name"`
-	Description string `json:"description"`
-	UpVotes     int    `json:"upVotes"`
-	DownVotes   int    `json:"downVotes"`
-	Confirmed   bool   `json:"confirmed"`
+	Id                string  `json:"id"`
+	Name              string  `json:"name"`
+	Description       string  `json:"description"`
+	UpVotes           int     `json:"upVotes"`
+	DownVotes         int     `json:"downVotes"`
+	WeightedUpVotes   float64 `json:"weightedUpVotes"`
+	WeightedDownVotes float64 `json:"weightedDownVotes"`
+	Confirmed         bool    `json:"confirmed"`
+	// MyVote is the vote of the address passed with the request, it is only set if the address is passed
+	MyVote string `json:"myVote,omitempty" enums:"up,down,none"`
+	// IsMine is true if the translation is submitted by the address passed with the request
+	IsMine bool `json:"isMine,omitempty"`
 } // @Name Translation
 
 type VoteRequest struct {
 	TranslationId string `json:"translationId"`
 	Up            bool   `json:"up"`
 	Timestamp     string `json:"timestamp" example:"2020-01-01T00:00:00Z"`
-	Signature     string `json:"signature"`
+	// Version is the format of the signed value, requests without version are signed in the legacy format
+	Version   uint8  `json:"version,omitempty" enums:"1,2"`
+	Signature string `json:"signature"`
 } // @Name VoteRequest
 
 type VoteResponse struct {
-	ResCode   byte   `json:"resCode" enums:"0,3,4,5"`
-	UpVotes   int    `json:"upVotes"`
-	DownVotes int    `json:"downVotes"`
-	Error     string `json:"error,omitempty"`
+	ResCode           byte    `json:"resCode" enums:"0,3,4,5,6,7,8"`
+	UpVotes           int     `json:"upVotes"`
+	DownVotes         int     `json:"downVotes"`
+	WeightedUpVotes   float64 `json:"weightedUpVotes"`
+	WeightedDownVotes float64 `json:"weightedDownVotes"`
+	Error             string  `json:"error,omitempty"`
+	// RetryAfter is the number of seconds after which the rate limited request can be repeated
+	RetryAfter int `json:"retryAfter,omitempty"`
 } // @Name VoteResponse
 
+type RetractVoteRequest struct {
+	TranslationId string `json:"translationId"`
+	Timestamp     string `json:"timestamp" example:"2020-01-01T00:00:00Z"`
+	// Version is the format of the signed value, retractions are only accepted in version 2
+	Version   uint8  `json:"version" enums:"2"`
+	Signature string `json:"signature"`
+} // @Name RetractVoteRequest
+
+type RetractVoteResponse struct {
+	ResCode           byte    `json:"resCode" enums:"0,4,6,7,8,11"`
+	UpVotes           int     `json:"upVotes"`
+	DownVotes         int     `json:"downVotes"`
+	WeightedUpVotes   float64 `json:"weightedUpVotes"`
+	WeightedDownVotes float64 `json:"weightedDownVotes"`
+	Error             string  `json:"error,omitempty"`
+	// RetryAfter is the number of seconds after which the rate limited request can be repeated
+	RetryAfter int `json:"retryAfter,omitempty"`
+} // @Name RetractVoteResponse
+
 type GetConfirmedTranslationResponse struct {
 	Translation *Translation `json:"translation"`
 } // @Name GetConfirmedTranslationResponse
+
+type GetConfirmedTranslationsByWordsRequest struct {
+	Words     []uint32 `json:"words"`
+	Languages []string `json:"languages"`
+} // @Name GetConfirmedTranslationsByWordsRequest
+
+type GetConfirmedTranslationsByWordsResponse struct {
+	// Translations are confirmed translations by language and word id, words without confirmed translation are null
+	Translations map[string]map[string]*Translation `json:"translations"`
+} // @Name GetConfirmedTranslationsByWordsResponse
+
+type GetAddressTranslationsResponse struct {
+	Translations []ContributedTranslation `json:"translations"`
+} // @Name GetAddressTranslationsResponse
+
+type GetAddressVotesResponse struct {
+	Votes []AddressVote `json:"votes"`
+} // @Name GetAddressVotesResponse
+
+// ContributedTranslation is the translation with the word and language it belongs to
+type ContributedTranslation struct {
+	Id                string  `json:"id"`
+	Word              uint32  `json:"word"`
+	Language          string  `json:"language"`
+	Name              string  `json:"name"`
+	Description       string  `json:"description"`
+	UpVotes           int     `json:"upVotes"`
+	DownVotes         int     `json:"downVotes"`
+	WeightedUpVotes   float64 `json:"weightedUpVotes"`
+	WeightedDownVotes float64 `json:"weightedDownVotes"`
+	Confirmed         bool    `json:"confirmed"`
+	// Status is the moderation status, the name and description of hidden and removed translations are empty
+	Status    string `json:"status" enums:"visible,hidden,removed"`
+	Timestamp string `json:"timestamp" example:"2020-01-01T00:00:00Z"`
+} // @Name ContributedTranslation
+
+type AddressVote struct {
+	Up     bool    `json:"up"`
+	Weight float64 `json:"weight"`
+	// Excluded is true if the vote is not counted since the voter was not eligible at the last revalidation
+	Excluded    bool                   `json:"excluded"`
+	Timestamp   string                 `json:"timestamp" example:"2020-01-01T00:00:00Z"`
+	Translation ContributedTranslation `json:"translation"`
+} // @Name AddressVote
+
+type GetTranslationHistoryResponse struct {
+	Revisions []TranslationRevision `json:"revisions"`
+} // @Name GetTranslationHistoryResponse
+
+type TranslationRevision struct {
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	UpVotes     int    `json:"upVotes"`
+	DownVotes   int    `json:"downVotes"`
+	Timestamp   string `json:"timestamp" example:"2020-01-01T00:00:00Z"`
+	ReplacedAt  string `json:"replacedAt" example:"2020-01-01T00:00:00Z"`
+} // @Name TranslationRevision
+
+type Language struct {
+	Name    string `json:"name" example:"pt-BR"`
+	Enabled bool   `json:"enabled"`
+} // @Name Language
+
+type GetLanguagesResponse struct {
+	Languages []LanguageCoverage `json:"languages"`
+} // @Name GetLanguagesResponse
+
+type LanguageCoverage struct {
+	Name    string `json:"name" example:"pt-BR"`
+	Enabled bool   `json:"enabled"`
+	// TranslatedWords is the number of words with at least one translation
+	TranslatedWords int `json:"translatedWords"`
+	// ConfirmedWords is the number of words with a confirmed translation
+	ConfirmedWords int `json:"confirmedWords"`
+	// Coverage is the percentage of the word list with a confirmed translation, it is missing if the word list is
+	// not loaded
+	Coverage *float64 `json:"coverage,omitempty" example:"12.5"`
+} // @Name LanguageCoverage
+
+type AddLanguageRequest struct {
+	Language string `json:"language" example:"pt-BR" maxLength:"35"`
+} // @Name AddLanguageRequest
+
+type ImportTranslationRow struct {
+	Word        uint32 `json:"word"`
+	Language    string `json:"language,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Address     string `json:"address,omitempty"`
+	UpVotes     int    `json:"upVotes,omitempty"`
+}
+
+type ImportTranslationsResponse struct {
+	DryRun    bool             `json:"dryRun"`
+	Imported  int              `json:"imported"`
+	Conflicts []ImportConflict `json:"conflicts"`
+	Errors    []ImportRowError `json:"errors"`
+} // @Name ImportTranslationsResponse
+
+type ImportConflict struct {
+	Row           int    `json:"row"`
+	Word          uint32 `json:"word"`
+	Language      string `json:"language"`
+	Address       string `json:"address"`
+	TranslationId string `json:"translationId"`
+} // @Name ImportConflict
+
+type ImportRowError struct {
+	Row   int    `json:"row"`
+	Error string `json:"error"`
+} // @Name ImportRowError
+
+type GetNodeStatusResponse struct {
+	Nodes []NodeStatus `json:"nodes"`
+	// IdentityCache is missing if the identity cache is disabled
+	IdentityCache *IdentityCacheStats `json:"identityCache,omitempty"`
+} // @Name GetNodeStatusResponse
+
+// IdentityCacheStats are numbers of identity requests served from the cache and sent to nodes since the start
+type IdentityCacheStats struct {
+	Hits   uint64 `json:"hits"`
+	Misses uint64 `json:"misses"`
+} // @Name IdentityCacheStats
+
+type NodeStatus struct {
+	Url                 string `json:"url"`
+	Healthy             bool   `json:"healthy"`
+	Breaker             string `json:"breaker" enums:"closed,open,half-open"`
+	ConsecutiveFailures int    `json:"consecutiveFailures"`
+	LastEpoch           uint16 `json:"lastEpoch"`
+	LastError           string `json:"lastError,omitempty"`
+	LastCheck           string `json:"lastCheck,omitempty" example:"2020-01-01T00:00:00Z"`
+} // @Name NodeStatus
+
+type ReportRequest struct {
+	TranslationId string `json:"translationId"`
+	Reason        string `json:"reason" enums:"spam,offensive,incorrect,other"`
+	Timestamp     string `json:"timestamp" example:"2020-01-01T00:00:00Z"`
+	// Version is the format of the signed value, reports are only accepted in version 2
+	Version   uint8  `json:"version" enums:"2"`
+	Signature string `json:"signature"`
+} // @Name ReportRequest
+
+type ReportResponse struct {
+	ResCode byte `json:"resCode" enums:"0,1,6,7,8,9,10"`
+	// Hidden is true if the translation is hidden pending review
+	Hidden bool   `json:"hidden"`
+	Error  string `json:"error,omitempty"`
+	// RetryAfter is the number of seconds after which the rate limited request can be repeated
+	RetryAfter int `json:"retryAfter,omitempty"`
+} // @Name ReportResponse
+
+type GetModerationQueueResponse struct {
+	Translations []ModeratedTranslation `json:"translations"`
+} // @Name GetModerationQueueResponse
+
+type ModeratedTranslation struct {
+	Id          string `json:"id"`
+	Word        uint32 `json:"word"`
+	Language    string `json:"language"`
+	Address     string `json:"address"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Status      string `json:"status" enums:"visible,hidden,removed"`
+	// Reports are numbers of unresolved reports by reason
+	Reports []ReportCount `json:"reports"`
+} // @Name ModeratedTranslation
+
+type ReportCount struct {
+	Reason string `json:"reason"`
+	Count  int    `json:"count"`
+} // @Name ReportCount
@@ -29,3 +29,71 @@ func (r VoteRequest) Validate() error {
 	}
 	return nil
 }
+
+func (r RetractVoteRequest) Validate() error {
+	var timestamp time.Time
+	if err := timestamp.UnmarshalText([]byte(r.Timestamp)); err != nil {
+		return errors.New("Invalid value 'timestamp'")
+	}
+	return nil
+}
+
+const (
+	maxBatchWords     = 1000
+	maxBatchLanguages = 10
+)
+
+func (r GetConfirmedTranslationsByWordsRequest) Validate() error {
+	if len(r.Words) == 0 || len(r.Words) > maxBatchWords {
+		return errors.New("Invalid number of words")
+	}
+	for _, word := range r.Words {
+		if word > 4615 {
+			return errors.New("Invalid value 'word'")
+		}
+	}
+	if len(r.Languages) == 0 || len(r.Languages) > maxBatchLanguages {
+		return errors.New("Invalid number of languages")
+	}
+	return nil
+}
+
+// ReportReasons are reasons of translation reports
+var ReportReasons = []string{"spam", "offensive", "incorrect", "other"}
+
+func (r ReportRequest) Validate() error {
+	validReason := false
+	for _, reason := range ReportReasons {
+		if r.Reason == reason {
+			validReason = true
+			break
+		}
+	}
+	if !validReason {
+		return errors.New("Invalid value 'reason'")
+	}
+	var timestamp time.Time
+	if err := timestamp.UnmarshalText([]byte(r.Timestamp)); err != nil {
+		return errors.New("Invalid value 'timestamp'")
+	}
+	return nil
+}
+
+func (r ImportTranslationRow) Validate() error {
+	if r.Word > 4615 {
+		return errors.New("Invalid value 'word'")
+	}
+	if name := []rune(r.Name); len(name) == 0 || len(name) > 30 {
+		return errors.New("Translation exceeds the maximum length")
+	}
+	if description := []rune(r.Description); len(description) > 150 {
+		return errors.New("Translation description exceeds the maximum length")
+	}
+	if len(r.Address) > 42 {
+		return errors.New("Invalid value 'address'")
+	}
+	if r.UpVotes < 0 {
+		return errors.New("Invalid value 'upVotes'")
+	}
+	return nil
+}